@@ -3,10 +3,11 @@
 
 package iata
 
+//go:generate go run gen.go
+
 import (
 	"database/sql/driver"
 	"fmt"
-	"strings"
 
 	"github.com/echa/code/iso"
 )
@@ -18,1232 +19,31 @@ type Airport struct {
 	Name    string
 	Country iso.Country
 	Region  iso.Region
+
+	// Facility data from ourairports.com. The built-in tables carry none,
+	// load it with LoadAirports.
+	Elevation     int           // field elevation in feet above mean sea level
+	HasElevation  bool          // false when the elevation is unknown
+	RunwayLength  int           // length of the longest runway in feet, zero when unknown
+	RunwaySurface RunwaySurface // surface of the longest runway
+	Scheduled     bool          // airport has scheduled airline service
 }
 
-// IATA Airport codes (large airports only)
-// https://ourairports.com/data/
-var (
-	IATA_LARGE_AIRPORT_CODES []AirportCode = []AirportCode{
-		"POM",
-		"KEF",
-		"PRN",
-		"YEG",
-		"YHZ",
-		"YOW",
-		"YUL",
-		"YVR",
-		"YWG",
-		"YYC",
-		"YYJ",
-		"YYT",
-		"YYZ",
-		"ALG",
-		"OUA",
-		"ACC",
-		"ABV",
-		"QUO",
-		"KAN",
-		"LOS",
-		"NIM",
-		"TUN",
-		"BRU",
-		"CRL",
-		"LGG",
-		"SXF",
-		"DRS",
-		"FRA",
-		"FMO",
-		"HAM",
-		"CGN",
-		"DUS",
-		"MUC",
-		"NUE",
-		"LEJ",
-		"STR",
-		"TXL",
-		"HAJ",
-		"BRE",
-		"DTM",
-		"FKB",
-		"TLL",
-		"HEL",
-		"BFS",
-		"BHD",
-		"BHX",
-		"MAN",
-		"DSA",
-		"CWL",
-		"BRS",
-		"LPL",
-		"LTN",
-		"BOH",
-		"SOU",
-		"LGW",
-		"LHR",
-		"LBA",
-		"NCL",
-		"EMA",
-		"ABZ",
-		"GLA",
-		"EDI",
-		"NWI",
-		"STN",
-		"EXT",
-		"LKZ",
-		"MHZ",
-		"FFD",
-		"BZZ",
-		"AMS",
-		"EIN",
-		"ORK",
-		"DUB",
-		"SNN",
-		"BLL",
-		"CPH",
-		"AAL",
-		"LUX",
-		"BOO",
-		"BGO",
-		"OSL",
-		"TOS",
-		"TRD",
-		"SVG",
-		"GDN",
-		"KRK",
-		"KTW",
-		"WMI",
-		"POZ",
-		"WAW",
-		"WRO",
-		"GOT",
-		"MMX",
-		"LLA",
-		"ARN",
-		"RMS",
-		"RIX",
-		"VNO",
-		"CPT",
-		"GRJ",
-		"JNB",
-		"DUR",
-		"GBE",
-		"SHO",
-		"MRU",
-		"LUN",
-		"TNR",
-		"LAD",
-		"MPM",
-		"SEZ",
-		"NDJ",
-		"HRE",
-		"WDH",
-		"FIH",
-		"BKO",
-		"SPC",
-		"LPA",
-		"TFS",
-		"TFN",
-		"FNA",
-		"ROB",
-		"CMN",
-		"DSS",
-		"DKR",
-		"NKC",
-		"SID",
-		"ADD",
-		"HGA",
-		"CAI",
-		"HRG",
-		"LXR",
-		"NBO",
-		"MBA",
-		"TIP",
-		"KGL",
-		"JUB",
-		"KRT",
-		"DAR",
-		"ZNZ",
-		"EBB",
-		"AAP",
-		"ABQ",
-		"ADW",
-		"AFW",
-		"AGS",
-		"AMA",
-		"ATL",
-		"AUS",
-		"AVL",
-		"BAB",
-		"BAD",
-		"BDL",
-		"BFI",
-		"BGR",
-		"BHM",
-		"BIL",
-		"BLV",
-		"BMI",
-		"BNA",
-		"BOI",
-		"BOS",
-		"BUF",
-		"BWI",
-		"CAE",
-		"CBM",
-		"CHA",
-		"CHS",
-		"CID",
-		"CLE",
-		"CLT",
-		"CMH",
-		"COS",
-		"CRP",
-		"CRW",
-		"CVG",
-		"CVS",
-		"DAB",
-		"DAL",
-		"DAY",
-		"DBQ",
-		"DCA",
-		"DEN",
-		"DFW",
-		"DLF",
-		"DLH",
-		"DOV",
-		"DSM",
-		"DTW",
-		"DYS",
-		"EDW",
-		"END",
-		"ERI",
-		"EWR",
-		"FFO",
-		"FLL",
-		"FSM",
-		"FTW",
-		"FWA",
-		"GEG",
-		"GPT",
-		"GRB",
-		"GSB",
-		"GSO",
-		"GSP",
-		"GUS",
-		"HIB",
-		"HMN",
-		"HOU",
-		"HSV",
-		"HTS",
-		"IAD",
-		"IAH",
-		"ICT",
-		"IND",
-		"JAN",
-		"JAX",
-		"JFK",
-		"JLN",
-		"LAS",
-		"LAX",
-		"LBB",
-		"LCK",
-		"LEX",
-		"LFI",
-		"LFT",
-		"LGA",
-		"LIT",
-		"LTS",
-		"LUF",
-		"MBS",
-		"MCF",
-		"MCI",
-		"MCO",
-		"MDW",
-		"MEM",
-		"MGE",
-		"MGM",
-		"MHT",
-		"MIA",
-		"MKE",
-		"MLI",
-		"MLU",
-		"MOB",
-		"MSN",
-		"MSP",
-		"MSY",
-		"MUO",
-		"OAK",
-		"OKC",
-		"OMA",
-		"ONT",
-		"ORD",
-		"ORF",
-		"PAM",
-		"PBI",
-		"PDX",
-		"PHF",
-		"PHL",
-		"PHX",
-		"PIA",
-		"PIT",
-		"PVD",
-		"PWM",
-		"RDU",
-		"RFD",
-		"RIC",
-		"RND",
-		"RNO",
-		"ROA",
-		"ROC",
-		"RST",
-		"RSW",
-		"SAN",
-		"SAT",
-		"SAV",
-		"SBN",
-		"SDF",
-		"SEA",
-		"SFB",
-		"SFO",
-		"SGF",
-		"SJC",
-		"SKA",
-		"SLC",
-		"SMF",
-		"SNA",
-		"SPI",
-		"SPS",
-		"SRQ",
-		"SSC",
-		"STL",
-		"SUS",
-		"SUU",
-		"SUX",
-		"SYR",
-		"SZL",
-		"TCM",
-		"TIK",
-		"TLH",
-		"TOL",
-		"TPA",
-		"TRI",
-		"TUL",
-		"TUS",
-		"TYS",
-		"VBG",
-		"VPS",
-		"WRB",
-		"TIA",
-		"BOJ",
-		"SOF",
-		"VAR",
-		"LCA",
-		"PFO",
-		"AKT",
-		"ZAG",
-		"ALC",
-		"BCN",
-		"MAD",
-		"AGP",
-		"PMI",
-		"SCQ",
-		"BOD",
-		"TLS",
-		"LYS",
-		"MRS",
-		"NCE",
-		"CDG",
-		"ORY",
-		"BSL",
-		"ATH",
-		"HER",
-		"SKG",
-		"BUD",
-		"BRI",
-		"CTA",
-		"PMO",
-		"CAG",
-		"MXP",
-		"BGY",
-		"TRN",
-		"GOA",
-		"LIN",
-		"BLQ",
-		"TSF",
-		"VRN",
-		"VCE",
-		"CIA",
-		"FCO",
-		"NAP",
-		"PSA",
-		"LJU",
-		"PRG",
-		"TLV",
-		"VDA",
-		"MLA",
-		"VIE",
-		"FAO",
-		"TER",
-		"PDL",
-		"OPO",
-		"LIS",
-		"SJJ",
-		"OTP",
-		"GVA",
-		"ZRH",
-		"ESB",
-		"ADA",
-		"AYT",
-		"GZT",
-		"ISL",
-		"ADB",
-		"DLM",
-		"ERZ",
-		"TZX",
-		"ISE",
-		"BJV",
-		"SAW",
-		"IST",
-		"SKP",
-		"BEG",
-		"TGD",
-		"BTS",
-		"PUJ",
-		"SDQ",
-		"GUA",
-		"KIN",
-		"ACA",
-		"GDL",
-		"HMO",
-		"MEX",
-		"MTY",
-		"PVR",
-		"SJD",
-		"TIJ",
-		"CUN",
-		"PTY",
-		"LIR",
-		"SAL",
-		"HAV",
-		"VRA",
-		"GCM",
-		"NAS",
-		"BZE",
-		"RAR",
-		"PPT",
-		"AKL",
-		"CHC",
-		"WLG",
-		"BAH",
-		"DMM",
-		"DHA",
-		"JED",
-		"MED",
-		"RUH",
-		"IKA",
-		"THR",
-		"MHD",
-		"SYZ",
-		"TBZ",
-		"AMM",
-		"KWI",
-		"BEY",
-		"DQM",
-		"MNH",
-		"AUH",
-		"DXB",
-		"DWC",
-		"SHJ",
-		"MCT",
-		"ISB",
-		"SKT",
-		"BGW",
-		"BSR",
-		"ALP",
-		"DAM",
-		"LTK",
-		"DOH",
-		"FAI",
-		"ANC",
-		"GUM",
-		"CGY",
-		"HNL",
-		"KNH",
-		"KHH",
-		"TPE",
-		"NRT",
-		"KIX",
-		"CTS",
-		"FUK",
-		"KOJ",
-		"NGO",
-		"FSZ",
-		"ITM",
-		"HND",
-		"OKO",
-		"MWX",
-		"KUV",
-		"CJU",
-		"PUS",
-		"ICN",
-		"OSN",
-		"GMP",
-		"CJJ",
-		"OKA",
-		"DNA",
-		"CRK",
-		"MNL",
-		"DVO",
-		"CEB",
-		"GRV",
-		"EZE",
-		"BEL",
-		"BSB",
-		"CNF",
-		"CWB",
-		"MAO",
-		"FLN",
-		"GIG",
-		"GRU",
-		"NAT",
-		"CGH",
-		"SSA",
-		"SCL",
-		"LTX",
-		"UIO",
-		"BOG",
-		"VVI",
-		"LIM",
-		"CUZ",
-		"MVD",
-		"BLA",
-		"CCS",
-		"PTP",
-		"SJU",
-		"NBE",
-		"SXM",
-		"ALA",
-		"TSE",
-		"FRU",
-		"KGF",
-		"GYD",
-		"EVN",
-		"TBS",
-		"KHV",
-		"KBP",
-		"SIP",
-		"HRK",
-		"ODS",
-		"LED",
-		"MSQ",
-		"KJA",
-		"OVB",
-		"ROV",
-		"AER",
-		"SVX",
-		"ASB",
-		"TAS",
-		"ZIA",
-		"DME",
-		"SVO",
-		"VKO",
-		"KZN",
-		"UFA",
-		"KUF",
-		"BOM",
-		"GOI",
-		"CMB",
-		"HRI",
-		"PNH",
-		"REP",
-		"CCU",
-		"DAC",
-		"HKG",
-		"ATQ",
-		"DEL",
-		"MFM",
-		"KTM",
-		"BLR",
-		"COK",
-		"CCJ",
-		"HYD",
-		"MAA",
-		"TRV",
-		"MLE",
-		"DMK",
-		"BKK",
-		"CNX",
-		"HKT",
-		"DAD",
-		"HAN",
-		"SGN",
-		"MDL",
-		"RGN",
-		"UPG",
-		"DPS",
-		"DJJ",
-		"SUB",
-		"SOQ",
-		"BWN",
-		"CGK",
-		"KNO",
-		"KUL",
-		"SIN",
-		"BNE",
-		"MEL",
-		"YNT",
-		"ADL",
-		"PER",
-		"CBR",
-		"SYD",
-		"PEK",
-		"PKX",
-		"HET",
-		"NAY",
-		"TSN",
-		"TYN",
-		"CAN",
-		"CSX",
-		"KWL",
-		"NNG",
-		"SZX",
-		"CGO",
-		"WUH",
-		"HAK",
-		"SYX",
-		"XIY",
-		"ULN",
-		"KMG",
-		"XMN",
-		"FOC",
-		"HGH",
-		"TNA",
-		"NGB",
-		"NKG",
-		"PVG",
-		"SHA",
-		"WNZ",
-		"CKG",
-		"KWE",
-		"CTU",
-		"URC",
-		"HRB",
-		"DLC",
-		"SHE",
-		"RUN",
-		"EIS",
-	}
+// Runway surface codes as used by ourairports.com
+type RunwaySurface string
 
-	IATA_LARGE_AIRPORTS = map[AirportCode]Airport{
-		"POM": {"POM", -9.44, 147.22, "Port Moresby", "PG", "PG-NCD"},
-		"KEF": {"KEF", 63.99, -22.61, "Reykjavík", "IS", "IS-2"},
-		"PRN": {"PRN", 42.57, 21.04, "Prishtina", "XK", "XK-01"},
-		"YEG": {"YEG", 53.31, -113.58, "Edmonton", "CA", "CA-AB"},
-		"YHZ": {"YHZ", 44.88, -63.51, "Halifax", "CA", "CA-NS"},
-		"YOW": {"YOW", 45.32, -75.67, "Ottawa", "CA", "CA-ON"},
-		"YUL": {"YUL", 45.47, -73.74, "Montréal", "CA", "CA-QC"},
-		"YVR": {"YVR", 49.19, -123.18, "Vancouver", "CA", "CA-BC"},
-		"YWG": {"YWG", 49.91, -97.24, "Winnipeg", "CA", "CA-MB"},
-		"YYC": {"YYC", 51.11, -114.02, "Calgary", "CA", "CA-AB"},
-		"YYJ": {"YYJ", 48.65, -123.43, "Victoria", "CA", "CA-BC"},
-		"YYT": {"YYT", 47.62, -52.75, "St. John's", "CA", "CA-NL"},
-		"YYZ": {"YYZ", 43.68, -79.63, "Toronto", "CA", "CA-ON"},
-		"ALG": {"ALG", 36.69, 3.22, "Algiers", "DZ", "DZ-35"},
-		"OUA": {"OUA", 12.35, -1.51, "Ouagadougou", "BF", "BF-KAD"},
-		"ACC": {"ACC", 5.61, -0.17, "Accra", "GH", "GH-AA"},
-		"ABV": {"ABV", 9.01, 7.26, "Abuja", "NG", "NG-FC"},
-		"QUO": {"QUO", 4.87, 8.09, "Uyo", "NG", "NG-AK"},
-		"KAN": {"KAN", 12.05, 8.52, "Kano", "NG", "NG-KN"},
-		"LOS": {"LOS", 6.58, 3.32, "Lagos", "NG", "NG-LA"},
-		"NIM": {"NIM", 13.48, 2.18, "Niamey", "NE", "NE-8"},
-		"TUN": {"TUN", 36.85, 10.23, "Tunis", "TN", "TN-11"},
-		"BRU": {"BRU", 50.9, 4.48, "Brussels", "BE", "BE-BRU"},
-		"CRL": {"CRL", 50.46, 4.45, "Brussels", "BE", "BE-WHT"},
-		"LGG": {"LGG", 50.64, 5.44, "Liège", "BE", "BE-WLG"},
-		"SXF": {"SXF", 52.38, 13.52, "Berlin", "DE", "DE-BR"},
-		"DRS": {"DRS", 51.13, 13.77, "Dresden", "DE", "DE-SN"},
-		"FRA": {"FRA", 50.03, 8.57, "Frankfurt am Main", "DE", "DE-HE"},
-		"FMO": {"FMO", 52.13, 7.68, "Münster", "DE", "DE-NW"},
-		"HAM": {"HAM", 53.63, 9.99, "Hamburg", "DE", "DE-HH"},
-		"CGN": {"CGN", 50.87, 7.14, "Cologne", "DE", "DE-NW"},
-		"DUS": {"DUS", 51.29, 6.77, "Düsseldorf", "DE", "DE-NW"},
-		"MUC": {"MUC", 48.35, 11.79, "Munich", "DE", "DE-BY"},
-		"NUE": {"NUE", 49.5, 11.08, "Nuremberg", "DE", "DE-BY"},
-		"LEJ": {"LEJ", 51.42, 12.24, "Leipzig", "DE", "DE-SN"},
-		"STR": {"STR", 48.69, 9.22, "Stuttgart", "DE", "DE-BW"},
-		"TXL": {"TXL", 52.56, 13.29, "Berlin", "DE", "DE-BE"},
-		"HAJ": {"HAJ", 52.46, 9.69, "Hannover", "DE", "DE-NI"},
-		"BRE": {"BRE", 53.05, 8.79, "Bremen", "DE", "DE-HB"},
-		"DTM": {"DTM", 51.52, 7.61, "Dortmund", "DE", "DE-NW"},
-		"FKB": {"FKB", 48.78, 8.08, "Baden-Baden", "DE", "DE-BW"},
-		"TLL": {"TLL", 59.41, 24.83, "Tallinn", "EE", "EE-37"},
-		"HEL": {"HEL", 60.32, 24.96, "Helsinki", "FI", "FI-ES"},
-		"BFS": {"BFS", 54.66, -6.22, "Belfast", "GB", "GB-NIR"},
-		"BHD": {"BHD", 54.62, -5.87, "Belfast", "GB", "GB-NIR"},
-		"BHX": {"BHX", 52.45, -1.75, "Birmingham", "GB", "GB-ENG"},
-		"MAN": {"MAN", 53.35, -2.27, "Manchester", "GB", "GB-ENG"},
-		"DSA": {"DSA", 53.48, -1.01, "Doncaster", "GB", "GB-ENG"},
-		"CWL": {"CWL", 51.4, -3.34, "Cardiff", "GB", "GB-WLS"},
-		"BRS": {"BRS", 51.38, -2.72, "Bristol", "GB", "GB-ENG"},
-		"LPL": {"LPL", 53.33, -2.85, "Liverpool", "GB", "GB-ENG"},
-		"LTN": {"LTN", 51.87, -0.37, "London", "GB", "GB-ENG"},
-		"BOH": {"BOH", 50.78, -1.84, "Bournemouth", "GB", "GB-ENG"},
-		"SOU": {"SOU", 50.95, -1.36, "Southampton", "GB", "GB-ENG"},
-		"LGW": {"LGW", 51.15, -0.19, "London", "GB", "GB-ENG"},
-		"LHR": {"LHR", 51.47, -0.46, "London", "GB", "GB-ENG"},
-		"LBA": {"LBA", 53.87, -1.66, "Leeds", "GB", "GB-ENG"},
-		"NCL": {"NCL", 55.04, -1.69, "Newcastle", "GB", "GB-ENG"},
-		"EMA": {"EMA", 52.83, -1.33, "Nottingham", "GB", "GB-ENG"},
-		"ABZ": {"ABZ", 57.2, -2.2, "Aberdeen", "GB", "GB-SCT"},
-		"GLA": {"GLA", 55.87, -4.43, "Glasgow", "GB", "GB-SCT"},
-		"EDI": {"EDI", 55.95, -3.37, "Edinburgh", "GB", "GB-SCT"},
-		"NWI": {"NWI", 52.68, 1.28, "Norwich", "GB", "GB-ENG"},
-		"STN": {"STN", 51.88, 0.23, "London", "GB", "GB-ENG"},
-		"EXT": {"EXT", 50.73, -3.41, "Exeter", "GB", "GB-ENG"},
-		"LKZ": {"LKZ", 52.41, 0.56, "Lakenheath", "GB", "GB-ENG"},
-		"MHZ": {"MHZ", 52.36, 0.49, "Mildenhall", "GB", "GB-ENG"},
-		"FFD": {"FFD", 51.68, -1.79, "Fairford", "GB", "GB-ENG"},
-		"BZZ": {"BZZ", 51.75, -1.58, "Brize Norton", "GB", "GB-ENG"},
-		"AMS": {"AMS", 52.31, 4.76, "Amsterdam", "NL", "NL-NH"},
-		"EIN": {"EIN", 51.45, 5.37, "Eindhoven", "NL", "NL-NB"},
-		"ORK": {"ORK", 51.84, -8.49, "Cork", "IE", "IE-C"},
-		"DUB": {"DUB", 53.42, -6.27, "Dublin", "IE", "IE-D"},
-		"SNN": {"SNN", 52.7, -8.92, "Shannon", "IE", "IE-CE"},
-		"BLL": {"BLL", 55.74, 9.15, "Billund", "DK", "DK-83"},
-		"CPH": {"CPH", 55.62, 12.66, "Copenhagen", "DK", "DK-84"},
-		"AAL": {"AAL", 57.09, 9.85, "Aalborg", "DK", "DK-81"},
-		"LUX": {"LUX", 49.62, 6.2, "Luxembourg", "LU", "LU-L"},
-		"BOO": {"BOO", 67.27, 14.37, "Bodø", "NO", "NO-18"},
-		"BGO": {"BGO", 60.29, 5.22, "Bergen", "NO", "NO-12"},
-		"OSL": {"OSL", 60.19, 11.1, "Oslo", "NO", "NO-02"},
-		"TOS": {"TOS", 69.68, 18.92, "Tromsø", "NO", "NO-19"},
-		"TRD": {"TRD", 63.46, 10.92, "Trondheim", "NO", "NO-17"},
-		"SVG": {"SVG", 58.88, 5.64, "Stavanger", "NO", "NO-11"},
-		"GDN": {"GDN", 54.38, 18.47, "Gdańsk", "PL", "PL-PM"},
-		"KRK": {"KRK", 50.08, 19.78, "Kraków", "PL", "PL-MA"},
-		"KTW": {"KTW", 50.47, 19.08, "Katowice", "PL", "PL-SL"},
-		"WMI": {"WMI", 52.45, 20.65, "Warsaw", "PL", "PL-MZ"},
-		"POZ": {"POZ", 52.42, 16.83, "Poznań", "PL", "PL-WP"},
-		"WAW": {"WAW", 52.17, 20.97, "Warsaw", "PL", "PL-MZ"},
-		"WRO": {"WRO", 51.1, 16.89, "Wrocław", "PL", "PL-DS"},
-		"GOT": {"GOT", 57.66, 12.28, "Gothenburg", "SE", "SE-Q"},
-		"MMX": {"MMX", 55.54, 13.38, "Malmö", "SE", "SE-M"},
-		"LLA": {"LLA", 65.54, 22.12, "Luleå", "SE", "SE-BD"},
-		"ARN": {"ARN", 59.65, 17.92, "Stockholm", "SE", "SE-AB"},
-		"RMS": {"RMS", 49.44, 7.6, "Ramstein", "DE", "DE-RP"},
-		"RIX": {"RIX", 56.92, 23.97, "Riga", "LV", "LV-RIX"},
-		"VNO": {"VNO", 54.63, 25.29, "Vilnius", "LT", "LT-VL"},
-		"CPT": {"CPT", -33.96, 18.6, "Cape Town", "ZA", "ZA-WC"},
-		"GRJ": {"GRJ", -34.01, 22.38, "George", "ZA", "ZA-WC"},
-		"JNB": {"JNB", -26.14, 28.25, "Johannesburg", "ZA", "ZA-U-A"},
-		"DUR": {"DUR", -29.61, 31.12, "Durban", "ZA", "ZA-NL"},
-		"GBE": {"GBE", -24.56, 25.92, "Gaborone", "BW", "BW-SE"},
-		"SHO": {"SHO", -26.36, 31.72, "", "SZ", "SZ-LU"},
-		"MRU": {"MRU", -20.43, 57.68, "Port Louis", "MU", "MU-GP"},
-		"LUN": {"LUN", -15.33, 28.45, "Lusaka", "ZM", "ZM-09"},
-		"TNR": {"TNR", -18.8, 47.48, "Antananarivo", "MG", "MG-T"},
-		"LAD": {"LAD", -8.86, 13.23, "Luanda", "AO", "AO-LUA"},
-		"MPM": {"MPM", -25.92, 32.57, "Maputo", "MZ", "MZ-MPM"},
-		"SEZ": {"SEZ", -4.67, 55.52, "Mahe Island", "SC", "SC-20"},
-		"NDJ": {"NDJ", 12.13, 15.03, "N'Djamena", "TD", "TD-CB"},
-		"HRE": {"HRE", -17.93, 31.09, "Harare", "ZW", "ZW-HA"},
-		"WDH": {"WDH", -22.48, 17.47, "Windhoek", "NA", "NA-KH"},
-		"FIH": {"FIH", -4.39, 15.44, "Kinshasa", "CD", "CD-KN"},
-		"BKO": {"BKO", 12.53, -7.95, "Bamako", "ML", "ML-2"},
-		"SPC": {"SPC", 28.63, -17.76, "Santa Cruz de la Palma", "ES", "ES-CN"},
-		"LPA": {"LPA", 27.93, -15.39, "Gran Canaria Island", "ES", "ES-CN"},
-		"TFS": {"TFS", 28.04, -16.57, "Tenerife Island", "ES", "ES-CN"},
-		"TFN": {"TFN", 28.48, -16.34, "Tenerife Island", "ES", "ES-CN"},
-		"FNA": {"FNA", 8.62, -13.2, "Freetown", "SL", "SL-N"},
-		"ROB": {"ROB", 6.23, -10.36, "Monrovia", "LR", "LR-MG"},
-		"CMN": {"CMN", 33.37, -7.59, "Casablanca", "MA", "MA-CAS"},
-		"DSS": {"DSS", 14.67, -17.07, "Dakar", "SN", "SN-DK"},
-		"DKR": {"DKR", 14.74, -17.49, "Dakar", "SN", "SN-DK"},
-		"NKC": {"NKC", 18.31, -15.97, "Nouakchott", "MR", "MR-NKC"},
-		"SID": {"SID", 16.74, -22.95, "Espargos", "CV", "CV-B"},
-		"ADD": {"ADD", 8.98, 38.8, "Addis Ababa", "ET", "ET-AA"},
-		"HGA": {"HGA", 9.51, 44.08, "Hargeisa", "SO", "SO-WO"},
-		"CAI": {"CAI", 30.12, 31.41, "Cairo", "EG", "EG-C"},
-		"HRG": {"HRG", 27.18, 33.8, "Hurghada", "EG", "EG-BA"},
-		"LXR": {"LXR", 25.67, 32.71, "Luxor", "EG", "EG-KN"},
-		"NBO": {"NBO", -1.32, 36.93, "Nairobi", "KE", "KE-110"},
-		"MBA": {"MBA", -4.03, 39.59, "Mombasa", "KE", "KE-300"},
-		"TIP": {"TIP", 32.66, 13.16, "Tripoli", "LY", "LY-TB"},
-		"KGL": {"KGL", -1.97, 30.14, "Kigali", "RW", "RW-01"},
-		"JUB": {"JUB", 4.87, 31.6, "Juba", "SS", "SS-17"},
-		"KRT": {"KRT", 15.59, 32.55, "Khartoum", "SD", "SD-03"},
-		"DAR": {"DAR", -6.88, 39.2, "Dar es Salaam", "TZ", "TZ-02"},
-		"ZNZ": {"ZNZ", -6.22, 39.22, "Zanzibar", "TZ", "TZ-07"},
-		"EBB": {"EBB", 0.04, 32.44, "Kampala", "UG", "UG-C"},
-		"AAP": {"AAP", -0.37, 117.25, "Samarinda", "ID", "ID-KI"},
-		"ABQ": {"ABQ", 35.04, -106.61, "Albuquerque", "US", "US-NM"},
-		"ADW": {"ADW", 38.81, -76.87, "Camp Springs", "US", "US-MD"},
-		"AFW": {"AFW", 32.99, -97.32, "Fort Worth", "US", "US-TX"},
-		"AGS": {"AGS", 33.37, -81.96, "Augusta", "US", "US-GA"},
-		"AMA": {"AMA", 35.22, -101.71, "Amarillo", "US", "US-TX"},
-		"ATL": {"ATL", 33.64, -84.43, "Atlanta", "US", "US-GA"},
-		"AUS": {"AUS", 30.19, -97.67, "Austin", "US", "US-TX"},
-		"AVL": {"AVL", 35.44, -82.54, "Asheville", "US", "US-NC"},
-		"BAB": {"BAB", 39.14, -121.44, "Marysville", "US", "US-CA"},
-		"BAD": {"BAD", 32.5, -93.66, "Bossier City", "US", "US-LA"},
-		"BDL": {"BDL", 41.94, -72.68, "Hartford", "US", "US-CT"},
-		"BFI": {"BFI", 47.53, -122.3, "Seattle", "US", "US-WA"},
-		"BGR": {"BGR", 44.81, -68.83, "Bangor", "US", "US-ME"},
-		"BHM": {"BHM", 33.56, -86.75, "Birmingham", "US", "US-AL"},
-		"BIL": {"BIL", 45.81, -108.54, "Billings", "US", "US-MT"},
-		"BLV": {"BLV", 38.55, -89.84, "Belleville", "US", "US-IL"},
-		"BMI": {"BMI", 40.48, -88.92, "Bloomington", "US", "US-IL"},
-		"BNA": {"BNA", 36.12, -86.68, "Nashville", "US", "US-TN"},
-		"BOI": {"BOI", 43.56, -116.22, "Boise", "US", "US-ID"},
-		"BOS": {"BOS", 42.36, -71.01, "Boston", "US", "US-MA"},
-		"BUF": {"BUF", 42.94, -78.73, "Buffalo", "US", "US-NY"},
-		"BWI": {"BWI", 39.18, -76.67, "Baltimore", "US", "US-MD"},
-		"CAE": {"CAE", 33.94, -81.12, "Columbia", "US", "US-SC"},
-		"CBM": {"CBM", 33.64, -88.44, "Columbus", "US", "US-MS"},
-		"CHA": {"CHA", 35.04, -85.2, "Chattanooga", "US", "US-TN"},
-		"CHS": {"CHS", 32.9, -80.04, "Charleston", "US", "US-SC"},
-		"CID": {"CID", 41.88, -91.71, "Cedar Rapids", "US", "US-IA"},
-		"CLE": {"CLE", 41.41, -81.85, "Cleveland", "US", "US-OH"},
-		"CLT": {"CLT", 35.21, -80.94, "Charlotte", "US", "US-NC"},
-		"CMH": {"CMH", 40, -82.89, "Columbus", "US", "US-OH"},
-		"COS": {"COS", 38.81, -104.7, "Colorado Springs", "US", "US-CO"},
-		"CRP": {"CRP", 27.77, -97.5, "Corpus Christi", "US", "US-TX"},
-		"CRW": {"CRW", 38.37, -81.59, "Charleston", "US", "US-WV"},
-		"CVG": {"CVG", 39.05, -84.67, "Cincinnati", "US", "US-KY"},
-		"CVS": {"CVS", 34.38, -103.32, "Clovis", "US", "US-NM"},
-		"DAB": {"DAB", 29.18, -81.06, "Daytona Beach", "US", "US-FL"},
-		"DAL": {"DAL", 32.85, -96.85, "Dallas", "US", "US-TX"},
-		"DAY": {"DAY", 39.9, -84.22, "Dayton", "US", "US-OH"},
-		"DBQ": {"DBQ", 42.4, -90.71, "Dubuque", "US", "US-IA"},
-		"DCA": {"DCA", 38.85, -77.04, "Washington", "US", "US-DC"},
-		"DEN": {"DEN", 39.86, -104.67, "Denver", "US", "US-CO"},
-		"DFW": {"DFW", 32.9, -97.04, "Dallas-Fort Worth", "US", "US-TX"},
-		"DLF": {"DLF", 29.36, -100.78, "Del Rio", "US", "US-TX"},
-		"DLH": {"DLH", 46.84, -92.19, "Duluth", "US", "US-MN"},
-		"DOV": {"DOV", 39.13, -75.47, "Dover", "US", "US-DE"},
-		"DSM": {"DSM", 41.53, -93.66, "Des Moines", "US", "US-IA"},
-		"DTW": {"DTW", 42.21, -83.35, "Detroit", "US", "US-MI"},
-		"DYS": {"DYS", 32.42, -99.85, "Abilene", "US", "US-TX"},
-		"EDW": {"EDW", 34.91, -117.88, "Edwards", "US", "US-CA"},
-		"END": {"END", 36.34, -97.92, "Enid", "US", "US-OK"},
-		"ERI": {"ERI", 42.08, -80.17, "Erie", "US", "US-PA"},
-		"EWR": {"EWR", 40.69, -74.17, "Newark", "US", "US-NJ"},
-		"FFO": {"FFO", 39.83, -84.05, "Dayton", "US", "US-OH"},
-		"FLL": {"FLL", 26.07, -80.15, "Fort Lauderdale", "US", "US-FL"},
-		"FSM": {"FSM", 35.34, -94.37, "Fort Smith", "US", "US-AR"},
-		"FTW": {"FTW", 32.82, -97.36, "Fort Worth", "US", "US-TX"},
-		"FWA": {"FWA", 40.98, -85.2, "Fort Wayne", "US", "US-IN"},
-		"GEG": {"GEG", 47.62, -117.53, "Spokane", "US", "US-WA"},
-		"GPT": {"GPT", 30.41, -89.07, "Gulfport", "US", "US-MS"},
-		"GRB": {"GRB", 44.49, -88.13, "Green Bay", "US", "US-WI"},
-		"GSB": {"GSB", 35.34, -77.96, "Goldsboro", "US", "US-NC"},
-		"GSO": {"GSO", 36.1, -79.94, "Greensboro", "US", "US-NC"},
-		"GSP": {"GSP", 34.9, -82.22, "Greenville", "US", "US-SC"},
-		"GUS": {"GUS", 40.65, -86.15, "Peru", "US", "US-IN"},
-		"HIB": {"HIB", 47.39, -92.84, "Hibbing", "US", "US-MN"},
-		"HMN": {"HMN", 32.85, -106.11, "Alamogordo", "US", "US-NM"},
-		"HOU": {"HOU", 29.65, -95.28, "Houston", "US", "US-TX"},
-		"HSV": {"HSV", 34.64, -86.78, "Huntsville", "US", "US-AL"},
-		"HTS": {"HTS", 38.37, -82.56, "Huntington", "US", "US-WV"},
-		"IAD": {"IAD", 38.94, -77.46, "Washington", "US", "US-DC"},
-		"IAH": {"IAH", 29.98, -95.34, "Houston", "US", "US-TX"},
-		"ICT": {"ICT", 37.65, -97.43, "Wichita", "US", "US-KS"},
-		"IND": {"IND", 39.72, -86.29, "Indianapolis", "US", "US-IN"},
-		"JAN": {"JAN", 32.31, -90.08, "Jackson", "US", "US-MS"},
-		"JAX": {"JAX", 30.49, -81.69, "Jacksonville", "US", "US-FL"},
-		"JFK": {"JFK", 40.64, -73.78, "New York", "US", "US-NY"},
-		"JLN": {"JLN", 37.15, -94.5, "Joplin", "US", "US-MO"},
-		"LAS": {"LAS", 36.08, -115.15, "Las Vegas", "US", "US-NV"},
-		"LAX": {"LAX", 33.94, -118.41, "Los Angeles", "US", "US-CA"},
-		"LBB": {"LBB", 33.66, -101.82, "Lubbock", "US", "US-TX"},
-		"LCK": {"LCK", 39.81, -82.93, "Columbus", "US", "US-OH"},
-		"LEX": {"LEX", 38.04, -84.61, "Lexington", "US", "US-KY"},
-		"LFI": {"LFI", 37.08, -76.36, "Hampton", "US", "US-VA"},
-		"LFT": {"LFT", 30.21, -91.99, "Lafayette", "US", "US-LA"},
-		"LGA": {"LGA", 40.78, -73.87, "New York", "US", "US-NY"},
-		"LIT": {"LIT", 34.73, -92.22, "Little Rock", "US", "US-AR"},
-		"LTS": {"LTS", 34.67, -99.27, "Altus", "US", "US-OK"},
-		"LUF": {"LUF", 33.53, -112.38, "Glendale", "US", "US-AZ"},
-		"MBS": {"MBS", 43.53, -84.08, "Saginaw", "US", "US-MI"},
-		"MCF": {"MCF", 27.85, -82.52, "Tampa", "US", "US-FL"},
-		"MCI": {"MCI", 39.3, -94.71, "Kansas City", "US", "US-MO"},
-		"MCO": {"MCO", 28.43, -81.31, "Orlando", "US", "US-FL"},
-		"MDW": {"MDW", 41.79, -87.75, "Chicago", "US", "US-IL"},
-		"MEM": {"MEM", 35.04, -89.98, "Memphis", "US", "US-TN"},
-		"MGE": {"MGE", 33.92, -84.52, "Marietta", "US", "US-GA"},
-		"MGM": {"MGM", 32.3, -86.39, "Montgomery", "US", "US-AL"},
-		"MHT": {"MHT", 42.93, -71.44, "Manchester", "US", "US-NH"},
-		"MIA": {"MIA", 25.79, -80.29, "Miami", "US", "US-FL"},
-		"MKE": {"MKE", 42.95, -87.9, "Milwaukee", "US", "US-WI"},
-		"MLI": {"MLI", 41.45, -90.51, "Moline", "US", "US-IL"},
-		"MLU": {"MLU", 32.51, -92.04, "Monroe", "US", "US-LA"},
-		"MOB": {"MOB", 30.69, -88.24, "Mobile", "US", "US-AL"},
-		"MSN": {"MSN", 43.14, -89.34, "Madison", "US", "US-WI"},
-		"MSP": {"MSP", 44.88, -93.22, "Minneapolis", "US", "US-MN"},
-		"MSY": {"MSY", 29.99, -90.26, "New Orleans", "US", "US-LA"},
-		"MUO": {"MUO", 43.04, -115.87, "Mountain Home", "US", "US-ID"},
-		"OAK": {"OAK", 37.72, -122.22, "Oakland", "US", "US-CA"},
-		"OKC": {"OKC", 35.39, -97.6, "Oklahoma City", "US", "US-OK"},
-		"OMA": {"OMA", 41.3, -95.89, "Omaha", "US", "US-NE"},
-		"ONT": {"ONT", 34.06, -117.6, "Ontario", "US", "US-CA"},
-		"ORD": {"ORD", 41.98, -87.9, "Chicago", "US", "US-IL"},
-		"ORF": {"ORF", 36.89, -76.2, "Norfolk", "US", "US-VA"},
-		"PAM": {"PAM", 30.07, -85.58, "Panama City", "US", "US-FL"},
-		"PBI": {"PBI", 26.68, -80.1, "West Palm Beach", "US", "US-FL"},
-		"PDX": {"PDX", 45.59, -122.6, "Portland", "US", "US-OR"},
-		"PHF": {"PHF", 37.13, -76.49, "Newport News", "US", "US-VA"},
-		"PHL": {"PHL", 39.87, -75.24, "Philadelphia", "US", "US-PA"},
-		"PHX": {"PHX", 33.43, -112.01, "Phoenix", "US", "US-AZ"},
-		"PIA": {"PIA", 40.66, -89.69, "Peoria", "US", "US-IL"},
-		"PIT": {"PIT", 40.49, -80.23, "Pittsburgh", "US", "US-PA"},
-		"PVD": {"PVD", 41.73, -71.42, "Providence", "US", "US-RI"},
-		"PWM": {"PWM", 43.65, -70.31, "Portland", "US", "US-ME"},
-		"RDU": {"RDU", 35.88, -78.79, "Raleigh", "US", "US-NC"},
-		"RFD": {"RFD", 42.2, -89.1, "Chicago", "US", "US-IL"},
-		"RIC": {"RIC", 37.51, -77.32, "Richmond", "US", "US-VA"},
-		"RND": {"RND", 29.53, -98.28, "Universal City", "US", "US-TX"},
-		"RNO": {"RNO", 39.5, -119.77, "Reno", "US", "US-NV"},
-		"ROA": {"ROA", 37.33, -79.98, "Roanoke", "US", "US-VA"},
-		"ROC": {"ROC", 43.12, -77.67, "Rochester", "US", "US-NY"},
-		"RST": {"RST", 43.91, -92.5, "Rochester", "US", "US-MN"},
-		"RSW": {"RSW", 26.54, -81.76, "Fort Myers", "US", "US-FL"},
-		"SAN": {"SAN", 32.73, -117.19, "San Diego", "US", "US-CA"},
-		"SAT": {"SAT", 29.53, -98.47, "San Antonio", "US", "US-TX"},
-		"SAV": {"SAV", 32.13, -81.2, "Savannah", "US", "US-GA"},
-		"SBN": {"SBN", 41.71, -86.32, "South Bend", "US", "US-IN"},
-		"SDF": {"SDF", 38.17, -85.74, "Louisville", "US", "US-KY"},
-		"SEA": {"SEA", 47.45, -122.31, "Seattle", "US", "US-WA"},
-		"SFB": {"SFB", 28.78, -81.24, "Orlando", "US", "US-FL"},
-		"SFO": {"SFO", 37.62, -122.38, "San Francisco", "US", "US-CA"},
-		"SGF": {"SGF", 37.25, -93.39, "Springfield", "US", "US-MO"},
-		"SJC": {"SJC", 37.36, -121.93, "San Jose", "US", "US-CA"},
-		"SKA": {"SKA", 47.62, -117.66, "Spokane", "US", "US-WA"},
-		"SLC": {"SLC", 40.79, -111.98, "Salt Lake City", "US", "US-UT"},
-		"SMF": {"SMF", 38.7, -121.59, "Sacramento", "US", "US-CA"},
-		"SNA": {"SNA", 33.68, -117.87, "Santa Ana", "US", "US-CA"},
-		"SPI": {"SPI", 39.84, -89.68, "Springfield", "US", "US-IL"},
-		"SPS": {"SPS", 33.99, -98.49, "Wichita Falls", "US", "US-TX"},
-		"SRQ": {"SRQ", 27.4, -82.55, "Sarasota", "US", "US-FL"},
-		"SSC": {"SSC", 33.97, -80.47, "Sumter", "US", "US-SC"},
-		"STL": {"STL", 38.75, -90.37, "St Louis", "US", "US-MO"},
-		"SUS": {"SUS", 38.66, -90.65, "St Louis", "US", "US-MO"},
-		"SUU": {"SUU", 38.26, -121.93, "Fairfield", "US", "US-CA"},
-		"SUX": {"SUX", 42.4, -96.38, "Sioux City", "US", "US-IA"},
-		"SYR": {"SYR", 43.11, -76.11, "Syracuse", "US", "US-NY"},
-		"SZL": {"SZL", 38.73, -93.55, "Knob Noster", "US", "US-MO"},
-		"TCM": {"TCM", 47.14, -122.48, "Tacoma", "US", "US-WA"},
-		"TIK": {"TIK", 35.41, -97.39, "Oklahoma City", "US", "US-OK"},
-		"TLH": {"TLH", 30.4, -84.35, "Tallahassee", "US", "US-FL"},
-		"TOL": {"TOL", 41.59, -83.81, "Toledo", "US", "US-OH"},
-		"TPA": {"TPA", 27.98, -82.53, "Tampa", "US", "US-FL"},
-		"TRI": {"TRI", 36.48, -82.41, "Bristol", "US", "US-TN"},
-		"TUL": {"TUL", 36.2, -95.89, "Tulsa", "US", "US-OK"},
-		"TUS": {"TUS", 32.12, -110.94, "Tucson", "US", "US-AZ"},
-		"TYS": {"TYS", 35.81, -83.99, "Knoxville", "US", "US-TN"},
-		"VBG": {"VBG", 34.74, -120.58, "Lompoc", "US", "US-CA"},
-		"VPS": {"VPS", 30.48, -86.53, "Valparaiso", "US", "US-FL"},
-		"WRB": {"WRB", 32.64, -83.59, "Warner Robins", "US", "US-GA"},
-		"TIA": {"TIA", 41.41, 19.72, "Tirana", "AL", "AL-11"},
-		"BOJ": {"BOJ", 42.57, 27.52, "Burgas", "BG", "BG-02"},
-		"SOF": {"SOF", 42.7, 23.41, "Sofia", "BG", "BG-23"},
-		"VAR": {"VAR", 43.23, 27.83, "Varna", "BG", "BG-03"},
-		"LCA": {"LCA", 34.88, 33.62, "Larnarca", "CY", "CY-04"},
-		"PFO": {"PFO", 34.72, 32.49, "Paphos", "CY", "CY-06"},
-		"AKT": {"AKT", 34.59, 32.99, "Akrotiri", "GB", "GB-U-A"},
-		"ZAG": {"ZAG", 45.74, 16.07, "Zagreb", "HR", "HR-21"},
-		"ALC": {"ALC", 38.28, -0.56, "Alicante", "ES", "ES-V"},
-		"BCN": {"BCN", 41.3, 2.08, "Barcelona", "ES", "ES-CT"},
-		"MAD": {"MAD", 40.47, -3.56, "Madrid", "ES", "ES-M"},
-		"AGP": {"AGP", 36.67, -4.5, "Málaga", "ES", "ES-AN"},
-		"PMI": {"PMI", 39.55, 2.74, "Palma De Mallorca", "ES", "ES-PM"},
-		"SCQ": {"SCQ", 42.9, -8.42, "Santiago de Compostela", "ES", "ES-GA"},
-		"BOD": {"BOD", 44.83, -0.72, "Bordeaux", "FR", "FR-NAQ"},
-		"TLS": {"TLS", 43.63, 1.36, "Toulouse", "FR", "FR-OCC"},
-		"LYS": {"LYS", 45.73, 5.08, "Lyon", "FR", "FR-ARA"},
-		"MRS": {"MRS", 43.44, 5.22, "Marseille", "FR", "FR-PAC"},
-		"NCE": {"NCE", 43.66, 7.22, "Nice", "FR", "FR-PAC"},
-		"CDG": {"CDG", 49.01, 2.55, "Paris", "FR", "FR-IDF"},
-		"ORY": {"ORY", 48.72, 2.38, "Paris", "FR", "FR-IDF"},
-		"BSL": {"BSL", 47.59, 7.53, "Bâle", "FR", "FR-GES"},
-		"ATH": {"ATH", 37.94, 23.94, "Athens", "GR", "GR-A1"},
-		"HER": {"HER", 35.34, 25.18, "Heraklion", "GR", "GR-91"},
-		"SKG": {"SKG", 40.52, 22.97, "Thessaloniki", "GR", "GR-54"},
-		"BUD": {"BUD", 47.43, 19.26, "Budapest", "HU", "HU-PE"},
-		"BRI": {"BRI", 41.14, 16.76, "Bari", "IT", "IT-75"},
-		"CTA": {"CTA", 37.47, 15.07, "Catania", "IT", "IT-82"},
-		"PMO": {"PMO", 38.18, 13.09, "Palermo", "IT", "IT-82"},
-		"CAG": {"CAG", 39.25, 9.05, "Cagliari", "IT", "IT-88"},
-		"MXP": {"MXP", 45.63, 8.73, "Milan", "IT", "IT-25"},
-		"BGY": {"BGY", 45.67, 9.7, "Bergamo", "IT", "IT-25"},
-		"TRN": {"TRN", 45.2, 7.65, "Torino", "IT", "IT-21"},
-		"GOA": {"GOA", 44.41, 8.84, "Genova", "IT", "IT-42"},
-		"LIN": {"LIN", 45.45, 9.28, "Milan", "IT", "IT-25"},
-		"BLQ": {"BLQ", 44.54, 11.29, "Bologna", "IT", "IT-45"},
-		"TSF": {"TSF", 45.65, 12.19, "Treviso", "IT", "IT-34"},
-		"VRN": {"VRN", 45.4, 10.89, "Verona", "IT", "IT-34"},
-		"VCE": {"VCE", 45.51, 12.35, "Venice", "IT", "IT-34"},
-		"CIA": {"CIA", 41.8, 12.59, "Rome", "IT", "IT-62"},
-		"FCO": {"FCO", 41.8, 12.24, "Rome", "IT", "IT-62"},
-		"NAP": {"NAP", 40.89, 14.29, "Nápoli", "IT", "IT-72"},
-		"PSA": {"PSA", 43.68, 10.39, "Pisa", "IT", "IT-52"},
-		"LJU": {"LJU", 46.22, 14.46, "Ljubljana", "SI", "SI-061"},
-		"PRG": {"PRG", 50.1, 14.26, "Prague", "CZ", "CZ-PR"},
-		"TLV": {"TLV", 32.01, 34.89, "Tel Aviv", "IL", "IL-M"},
-		"VDA": {"VDA", 29.94, 34.94, "Eilat", "IL", "IL-D"},
-		"MLA": {"MLA", 35.86, 14.48, "Valletta", "MT", "MT-25"},
-		"VIE": {"VIE", 48.11, 16.57, "Vienna", "AT", "AT-9"},
-		"FAO": {"FAO", 37.01, -7.97, "Faro", "PT", "PT-08"},
-		"TER": {"TER", 38.76, -27.09, "Praia da Vitória", "PT", "PT-20"},
-		"PDL": {"PDL", 37.74, -25.7, "Ponta Delgada", "PT", "PT-20"},
-		"OPO": {"OPO", 41.25, -8.68, "Porto", "PT", "PT-13"},
-		"LIS": {"LIS", 38.78, -9.14, "Lisbon", "PT", "PT-11"},
-		"SJJ": {"SJJ", 43.82, 18.33, "Sarajevo", "BA", "BA-BIH"},
-		"OTP": {"OTP", 44.57, 26.09, "Bucharest", "RO", "RO-B"},
-		"GVA": {"GVA", 46.24, 6.11, "Geneva", "CH", "CH-GE"},
-		"ZRH": {"ZRH", 47.46, 8.55, "Zurich", "CH", "CH-ZH"},
-		"ESB": {"ESB", 40.13, 33, "Ankara", "TR", "TR-06"},
-		"ADA": {"ADA", 36.98, 35.28, "Adana", "TR", "TR-01"},
-		"AYT": {"AYT", 36.9, 30.8, "Antalya", "TR", "TR-07"},
-		"GZT": {"GZT", 36.95, 37.48, "Gaziantep", "TR", "TR-27"},
-		"ISL": {"ISL", 40.98, 28.81, "Istanbul", "TR", "TR-34"},
-		"ADB": {"ADB", 38.29, 27.16, "İzmir", "TR", "TR-35"},
-		"DLM": {"DLM", 36.71, 28.79, "Dalaman", "TR", "TR-48"},
-		"ERZ": {"ERZ", 39.96, 41.17, "Erzurum", "TR", "TR-25"},
-		"TZX": {"TZX", 41, 39.79, "Trabzon", "TR", "TR-61"},
-		"ISE": {"ISE", 37.86, 30.37, "Isparta", "TR", "TR-32"},
-		"BJV": {"BJV", 37.25, 27.66, "Bodrum", "TR", "TR-48"},
-		"SAW": {"SAW", 40.9, 29.31, "Istanbul", "TR", "TR-34"},
-		"IST": {"IST", 41.28, 28.75, "Istanbul", "TR", "TR-34"},
-		"SKP": {"SKP", 41.96, 21.62, "Skopje", "MK", "MK-004"},
-		"BEG": {"BEG", 44.82, 20.31, "Belgrade", "RS", "RS-00"},
-		"TGD": {"TGD", 42.36, 19.25, "Podgorica", "ME", "ME-16"},
-		"BTS": {"BTS", 48.17, 17.21, "Bratislava", "SK", "SK-BL"},
-		"PUJ": {"PUJ", 18.57, -68.36, "Punta Cana", "DO", "DO-11"},
-		"SDQ": {"SDQ", 18.43, -69.67, "Santo Domingo", "DO", "DO-01"},
-		"GUA": {"GUA", 14.58, -90.53, "Guatemala City", "GT", "GT-GU"},
-		"KIN": {"KIN", 17.94, -76.79, "Kingston", "JM", "JM-01"},
-		"ACA": {"ACA", 16.76, -99.75, "Acapulco", "MX", "MX-GRO"},
-		"GDL": {"GDL", 20.52, -103.31, "Guadalajara", "MX", "MX-JAL"},
-		"HMO": {"HMO", 29.1, -111.05, "Hermosillo", "MX", "MX-SON"},
-		"MEX": {"MEX", 19.44, -99.07, "Mexico City", "MX", "MX-DIF"},
-		"MTY": {"MTY", 25.78, -100.11, "Monterrey", "MX", "MX-NLE"},
-		"PVR": {"PVR", 20.68, -105.25, "Puerto Vallarta", "MX", "MX-JAL"},
-		"SJD": {"SJD", 23.15, -109.72, "San José del Cabo", "MX", "MX-BCS"},
-		"TIJ": {"TIJ", 32.54, -116.97, "Tijuana", "MX", "MX-BCN"},
-		"CUN": {"CUN", 21.04, -86.88, "Cancún", "MX", "MX-ROO"},
-		"PTY": {"PTY", 9.07, -79.38, "Tocumen", "PA", "PA-8"},
-		"LIR": {"LIR", 10.59, -85.54, "Liberia", "CR", "CR-G"},
-		"SAL": {"SAL", 13.44, -89.06, "San Salvador (San Luis Talpa)", "SV", "SV-PA"},
-		"HAV": {"HAV", 22.99, -82.41, "Havana", "CU", "CU-03"},
-		"VRA": {"VRA", 23.03, -81.44, "Varadero", "CU", "CU-04"},
-		"GCM": {"GCM", 19.29, -81.36, "Georgetown", "KY", "KY-U-A"},
-		"NAS": {"NAS", 25.04, -77.47, "Nassau", "BS", "BS-NP"},
-		"BZE": {"BZE", 17.54, -88.31, "Belize City", "BZ", "BZ-BZ"},
-		"RAR": {"RAR", -21.2, -159.81, "Avarua", "CK", "CK-U-A"},
-		"PPT": {"PPT", -17.55, -149.61, "Papeete", "PF", "PF-U-A"},
-		"AKL": {"AKL", -37.01, 174.79, "Auckland", "NZ", "NZ-AUK"},
-		"CHC": {"CHC", -43.49, 172.53, "Christchurch", "NZ", "NZ-CAN"},
-		"WLG": {"WLG", -41.33, 174.8, "Wellington", "NZ", "NZ-WGN"},
-		"BAH": {"BAH", 26.27, 50.63, "Manama", "BH", "BH-15"},
-		"DMM": {"DMM", 26.47, 49.8, "Ad Dammam", "SA", "SA-04"},
-		"DHA": {"DHA", 26.27, 50.15, "", "SA", "SA-04"},
-		"JED": {"JED", 21.68, 39.16, "Jeddah", "SA", "SA-02"},
-		"MED": {"MED", 24.55, 39.71, "Medina", "SA", "SA-03"},
-		"RUH": {"RUH", 24.96, 46.7, "Riyadh", "SA", "SA-01"},
-		"IKA": {"IKA", 35.42, 51.15, "Tehran", "IR", "IR-07"},
-		"THR": {"THR", 35.69, 51.31, "Tehran", "IR", "IR-07"},
-		"MHD": {"MHD", 36.24, 59.64, "Mashhad", "IR", "IR-30"},
-		"SYZ": {"SYZ", 29.54, 52.59, "Shiraz", "IR", "IR-14"},
-		"TBZ": {"TBZ", 38.13, 46.24, "Tabriz", "IR", "IR-01"},
-		"AMM": {"AMM", 31.72, 35.99, "Amman", "JO", "JO-AM"},
-		"KWI": {"KWI", 29.23, 47.97, "Kuwait City", "KW", "KW-FA"},
-		"BEY": {"BEY", 33.82, 35.49, "Beirut", "LB", "LB-JL"},
-		"DQM": {"DQM", 19.5, 57.63, "Duqm", "OM", "OM-WU"},
-		"MNH": {"MNH", 23.64, 57.49, "Al Masna'ah", "OM", "OM-BA"},
-		"AUH": {"AUH", 24.43, 54.65, "Abu Dhabi", "AE", "AE-AZ"},
-		"DXB": {"DXB", 25.25, 55.36, "Dubai", "AE", "AE-DU"},
-		"DWC": {"DWC", 24.9, 55.16, "Jebel Ali", "AE", "AE-DU"},
-		"SHJ": {"SHJ", 25.33, 55.52, "Sharjah", "AE", "AE-SH"},
-		"MCT": {"MCT", 23.59, 58.28, "Muscat", "OM", "OM-MA"},
-		"ISB": {"ISB", 33.55, 72.83, "Islamabad", "PK", "PK-PB"},
-		"SKT": {"SKT", 32.54, 74.36, "Sialkot", "PK", "PK-PB"},
-		"BGW": {"BGW", 33.26, 44.23, "Baghdad", "IQ", "IQ-BG"},
-		"BSR": {"BSR", 30.55, 47.66, "Basrah", "IQ", "IQ-BA"},
-		"ALP": {"ALP", 36.18, 37.22, "Aleppo", "SY", "SY-HL"},
-		"DAM": {"DAM", 33.41, 36.52, "Damascus", "SY", "SY-DI"},
-		"LTK": {"LTK", 35.4, 35.95, "Latakia", "SY", "SY-LA"},
-		"DOH": {"DOH", 25.27, 51.61, "Doha", "QA", "QA-DA"},
-		"FAI": {"FAI", 64.82, -147.86, "Fairbanks", "US", "US-AK"},
-		"ANC": {"ANC", 61.17, -150, "Anchorage", "US", "US-AK"},
-		"GUM": {"GUM", 13.48, 144.8, "Hagåtña, Guam International Airport", "GU", "GU-U-A"},
-		"CGY": {"CGY", 8.61, 124.46, "Cagayan de Oro City", "PH", "PH-MSR"},
-		"HNL": {"HNL", 21.32, -157.92, "Honolulu", "US", "US-HI"},
-		"KNH": {"KNH", 24.43, 118.36, "Shang-I", "TW", "TW-X-KM"},
-		"KHH": {"KHH", 22.58, 120.35, "Kaohsiung City", "TW", "TW-KHH"},
-		"TPE": {"TPE", 25.08, 121.23, "Taipei", "TW", "TW-TAO"},
-		"NRT": {"NRT", 35.76, 140.39, "Tokyo", "JP", "JP-12"},
-		"KIX": {"KIX", 34.43, 135.24, "Osaka", "JP", "JP-27"},
-		"CTS": {"CTS", 42.78, 141.69, "Chitose", "JP", "JP-01"},
-		"FUK": {"FUK", 33.59, 130.45, "Fukuoka", "JP", "JP-40"},
-		"KOJ": {"KOJ", 31.8, 130.72, "Kagoshima", "JP", "JP-46"},
-		"NGO": {"NGO", 34.86, 136.8, "Tokoname", "JP", "JP-23"},
-		"FSZ": {"FSZ", 34.8, 138.19, "Makinohara", "JP", "JP-22"},
-		"ITM": {"ITM", 34.79, 135.44, "Osaka", "JP", "JP-27"},
-		"HND": {"HND", 35.55, 139.78, "Ota, Tokyo", "JP", "JP-13"},
-		"OKO": {"OKO", 35.75, 139.35, "Fussa", "JP", "JP-13"},
-		"MWX": {"MWX", 34.99, 126.38, "Piseo-ri (Muan)", "KR", "KR-46"},
-		"KUV": {"KUV", 35.9, 126.62, "Kunsan", "KR", "KR-45"},
-		"CJU": {"CJU", 33.51, 126.49, "Jeju City", "KR", "KR-49"},
-		"PUS": {"PUS", 35.18, 128.94, "Busan", "KR", "KR-26"},
-		"ICN": {"ICN", 37.47, 126.45, "Seoul", "KR", "KR-28"},
-		"OSN": {"OSN", 37.09, 127.03, "", "KR", "KR-41"},
-		"GMP": {"GMP", 37.56, 126.79, "Seoul", "KR", "KR-11"},
-		"CJJ": {"CJJ", 36.72, 127.5, "Cheongju", "KR", "KR-43"},
-		"OKA": {"OKA", 26.2, 127.65, "Naha", "JP", "JP-47"},
-		"DNA": {"DNA", 26.36, 127.77, "", "JP", "JP-47"},
-		"CRK": {"CRK", 15.19, 120.56, "Angeles", "PH", "PH-PAM"},
-		"MNL": {"MNL", 14.51, 121.02, "Pasay", "PH", "PH-U-A"},
-		"DVO": {"DVO", 7.13, 125.65, "Davao City", "PH", "PH-DAV"},
-		"CEB": {"CEB", 10.31, 123.98, "Lapu-Lapu City", "PH", "PH-CEB"},
-		"GRV": {"GRV", 43.39, 45.7, "Grozny", "RU", "RU-CE"},
-		"EZE": {"EZE", -34.82, -58.54, "Buenos Aires", "AR", "AR-B"},
-		"BEL": {"BEL", -1.38, -48.48, "Belém", "BR", "BR-PA"},
-		"BSB": {"BSB", -15.87, -47.92, "Brasília", "BR", "BR-DF"},
-		"CNF": {"CNF", -19.62, -43.97, "Belo Horizonte", "BR", "BR-MG"},
-		"CWB": {"CWB", -25.53, -49.18, "Curitiba", "BR", "BR-PR"},
-		"MAO": {"MAO", -3.04, -60.05, "Manaus", "BR", "BR-AM"},
-		"FLN": {"FLN", -27.67, -48.55, "Florianópolis", "BR", "BR-SC"},
-		"GIG": {"GIG", -22.81, -43.25, "Rio De Janeiro", "BR", "BR-RJ"},
-		"GRU": {"GRU", -23.44, -46.47, "São Paulo", "BR", "BR-SP"},
-		"NAT": {"NAT", -5.77, -35.38, "Natal", "BR", "BR-RN"},
-		"CGH": {"CGH", -23.63, -46.66, "São Paulo", "BR", "BR-SP"},
-		"SSA": {"SSA", -12.91, -38.32, "Salvador", "BR", "BR-BA"},
-		"SCL": {"SCL", -33.39, -70.79, "Santiago", "CL", "CL-RM"},
-		"LTX": {"LTX", -0.91, -78.62, "Latacunga", "EC", "EC-X"},
-		"UIO": {"UIO", -0.13, -78.36, "Quito", "EC", "EC-P"},
-		"BOG": {"BOG", 4.7, -74.15, "Bogota", "CO", "CO-CUN"},
-		"VVI": {"VVI", -17.64, -63.14, "Santa Cruz", "BO", "BO-S"},
-		"LIM": {"LIM", -12.02, -77.11, "Lima", "PE", "PE-LIM"},
-		"CUZ": {"CUZ", -13.54, -71.94, "Cusco", "PE", "PE-CUS"},
-		"MVD": {"MVD", -34.84, -56.03, "Montevideo", "UY", "UY-CA"},
-		"BLA": {"BLA", 10.11, -64.69, "Barcelona", "VE", "VE-B"},
-		"CCS": {"CCS", 10.6, -66.99, "Caracas", "VE", "VE-X"},
-		"PTP": {"PTP", 16.27, -61.53, "Pointe-à-Pitre", "GP", "GP-U-A"},
-		"SJU": {"SJU", 18.44, -66, "San Juan", "PR", "PR-U-A"},
-		"NBE": {"NBE", 36.08, 10.44, "Enfidha", "TN", "TN-51"},
-		"SXM": {"SXM", 18.04, -63.11, "Saint Martin", "SX", "SX-U-A"},
-		"ALA": {"ALA", 43.35, 77.04, "Almaty", "KZ", "KZ-ALM"},
-		"TSE": {"TSE", 51.02, 71.47, "Astana", "KZ", "KZ-AKM"},
-		"FRU": {"FRU", 43.06, 74.48, "Bishkek", "KG", "KG-C"},
-		"KGF": {"KGF", 49.67, 73.33, "Karaganda", "KZ", "KZ-KAR"},
-		"GYD": {"GYD", 40.47, 50.05, "Baku", "AZ", "AZ-BA"},
-		"EVN": {"EVN", 40.15, 44.4, "Yerevan", "AM", "AM-ER"},
-		"TBS": {"TBS", 41.67, 44.95, "Tbilisi", "GE", "GE-TB"},
-		"KHV": {"KHV", 48.53, 135.19, "Khabarovsk", "RU", "RU-KHA"},
-		"KBP": {"KBP", 50.35, 30.89, "Kyiv", "UA", "UA-32"},
-		"SIP": {"SIP", 45.05, 33.98, "Simferopol", "UA", "UA-43"},
-		"HRK": {"HRK", 49.92, 36.29, "Kharkiv", "UA", "UA-63"},
-		"ODS": {"ODS", 46.43, 30.68, "Odessa", "UA", "UA-51"},
-		"LED": {"LED", 59.8, 30.26, "St. Petersburg", "RU", "RU-SPE"},
-		"MSQ": {"MSQ", 53.88, 28.03, "Minsk", "BY", "BY-MI"},
-		"KJA": {"KJA", 56.17, 92.49, "Krasnoyarsk", "RU", "RU-KYA"},
-		"OVB": {"OVB", 55.01, 82.65, "Novosibirsk", "RU", "RU-NVS"},
-		"ROV": {"ROV", 47.49, 39.92, "Rostov-on-Don", "RU", "RU-ROS"},
-		"AER": {"AER", 43.45, 39.96, "Sochi", "RU", "RU-KDA"},
-		"SVX": {"SVX", 56.74, 60.8, "Yekaterinburg", "RU", "RU-SVE"},
-		"ASB": {"ASB", 37.99, 58.36, "Ashgabat", "TM", "TM-A"},
-		"TAS": {"TAS", 41.26, 69.28, "Tashkent", "UZ", "UZ-TO"},
-		"ZIA": {"ZIA", 55.55, 38.15, "Moscow", "RU", "RU-MOS"},
-		"DME": {"DME", 55.41, 37.91, "Moscow", "RU", "RU-MOS"},
-		"SVO": {"SVO", 55.97, 37.41, "Moscow", "RU", "RU-MOS"},
-		"VKO": {"VKO", 55.59, 37.26, "Moscow", "RU", "RU-MOS"},
-		"KZN": {"KZN", 55.61, 49.28, "Kazan", "RU", "RU-TA"},
-		"UFA": {"UFA", 54.56, 55.87, "Ufa", "RU", "RU-BA"},
-		"KUF": {"KUF", 53.5, 50.16, "Samara", "RU", "RU-SAM"},
-		"BOM": {"BOM", 19.09, 72.87, "Mumbai", "IN", "IN-MM"},
-		"GOI": {"GOI", 15.38, 73.83, "Vasco da Gama", "IN", "IN-GA"},
-		"CMB": {"CMB", 7.18, 79.88, "Colombo", "LK", "LK-1"},
-		"HRI": {"HRI", 6.28, 81.12, "", "LK", "LK-3"},
-		"PNH": {"PNH", 11.55, 104.84, "Phnom Penh", "KH", "KH-8"},
-		"REP": {"REP", 13.41, 103.81, "Siem Reap", "KH", "KH-17"},
-		"CCU": {"CCU", 22.65, 88.45, "Kolkata", "IN", "IN-WB"},
-		"DAC": {"DAC", 23.84, 90.4, "Dhaka", "BD", "BD-3"},
-		"HKG": {"HKG", 22.31, 113.92, "Hong Kong", "HK", "HK-U-A"},
-		"ATQ": {"ATQ", 31.71, 74.8, "Amritsar", "IN", "IN-PB"},
-		"DEL": {"DEL", 28.57, 77.1, "New Delhi", "IN", "IN-DL"},
-		"MFM": {"MFM", 22.15, 113.59, "Macau", "MO", "MO-U-A"},
-		"KTM": {"KTM", 27.7, 85.36, "Kathmandu", "NP", "NP-BA"},
-		"BLR": {"BLR", 13.2, 77.71, "Bangalore", "IN", "IN-KA"},
-		"COK": {"COK", 10.15, 76.4, "Kochi", "IN", "IN-KL"},
-		"CCJ": {"CCJ", 11.14, 75.96, "Calicut", "IN", "IN-KL"},
-		"HYD": {"HYD", 17.23, 78.43, "Hyderabad", "IN", "IN-TG"},
-		"MAA": {"MAA", 12.99, 80.17, "Chennai", "IN", "IN-TN"},
-		"TRV": {"TRV", 8.48, 76.92, "Thiruvananthapuram", "IN", "IN-KL"},
-		"MLE": {"MLE", 4.19, 73.53, "Malé", "MV", "MV-MLE"},
-		"DMK": {"DMK", 13.91, 100.61, "Bangkok", "TH", "TH-10"},
-		"BKK": {"BKK", 13.68, 100.75, "Bangkok", "TH", "TH-10"},
-		"CNX": {"CNX", 18.77, 98.96, "Chiang Mai", "TH", "TH-50"},
-		"HKT": {"HKT", 8.11, 98.32, "Phuket", "TH", "TH-83"},
-		"DAD": {"DAD", 16.04, 108.2, "Da Nang", "VN", "VN-60"},
-		"HAN": {"HAN", 21.22, 105.81, "Hanoi", "VN", "VN-15"},
-		"SGN": {"SGN", 10.82, 106.65, "Ho Chi Minh City", "VN", "VN-23"},
-		"MDL": {"MDL", 21.7, 95.98, "Mandalay", "MM", "MM-04"},
-		"RGN": {"RGN", 16.91, 96.13, "Yangon", "MM", "MM-06"},
-		"UPG": {"UPG", -5.06, 119.55, "Ujung Pandang-Celebes Island", "ID", "ID-SN"},
-		"DPS": {"DPS", -8.75, 115.17, "Denpasar-Bali Island", "ID", "ID-BA"},
-		"DJJ": {"DJJ", -2.58, 140.52, "Jayapura-Papua Island", "ID", "ID-PA"},
-		"SUB": {"SUB", -7.38, 112.79, "Surabaya", "ID", "ID-JI"},
-		"SOQ": {"SOQ", -0.89, 131.29, "Sorong-Papua Island", "ID", "ID-PB"},
-		"BWN": {"BWN", 4.94, 114.93, "Bandar Seri Begawan", "BN", "BN-BM"},
-		"CGK": {"CGK", -6.13, 106.66, "Jakarta", "ID", "ID-BT"},
-		"KNO": {"KNO", 3.64, 98.89, "", "ID", "ID-SU"},
-		"KUL": {"KUL", 2.75, 101.71, "Kuala Lumpur", "MY", "MY-14"},
-		"SIN": {"SIN", 1.35, 103.99, "Singapore", "SG", "SG-04"},
-		"BNE": {"BNE", -27.38, 153.12, "Brisbane", "AU", "AU-QLD"},
-		"MEL": {"MEL", -37.67, 144.84, "Melbourne", "AU", "AU-VIC"},
-		"YNT": {"YNT", 37.66, 120.99, "Yantai", "CN", "CN-37"},
-		"ADL": {"ADL", -34.95, 138.53, "Adelaide", "AU", "AU-SA"},
-		"PER": {"PER", -31.94, 115.97, "Perth", "AU", "AU-WA"},
-		"CBR": {"CBR", -35.31, 149.2, "Canberra", "AU", "AU-ACT"},
-		"SYD": {"SYD", -33.95, 151.18, "Sydney", "AU", "AU-NSW"},
-		"PEK": {"PEK", 40.08, 116.58, "Beijing", "CN", "CN-11"},
-		"PKX": {"PKX", 39.51, 116.41, "Beijing", "CN", "CN-13"},
-		"HET": {"HET", 40.85, 111.82, "Hohhot", "CN", "CN-15"},
-		"NAY": {"NAY", 39.78, 116.39, "Beijing", "CN", "CN-11"},
-		"TSN": {"TSN", 39.12, 117.35, "Tianjin", "CN", "CN-12"},
-		"TYN": {"TYN", 37.75, 112.63, "Taiyuan", "CN", "CN-14"},
-		"CAN": {"CAN", 23.39, 113.3, "Guangzhou", "CN", "CN-44"},
-		"CSX": {"CSX", 28.19, 113.22, "Changsha", "CN", "CN-43"},
-		"KWL": {"KWL", 25.22, 110.04, "Guilin City", "CN", "CN-45"},
-		"NNG": {"NNG", 22.61, 108.17, "Nanning", "CN", "CN-45"},
-		"SZX": {"SZX", 22.64, 113.81, "Shenzhen", "CN", "CN-44"},
-		"CGO": {"CGO", 34.52, 113.84, "Zhengzhou", "CN", "CN-41"},
-		"WUH": {"WUH", 30.78, 114.21, "Wuhan", "CN", "CN-42"},
-		"HAK": {"HAK", 19.93, 110.46, "Haikou", "CN", "CN-46"},
-		"SYX": {"SYX", 18.3, 109.41, "Sanya", "CN", "CN-46"},
-		"XIY": {"XIY", 34.45, 108.75, "Xi'an", "CN", "CN-61"},
-		"ULN": {"ULN", 47.84, 106.77, "Ulan Bator", "MN", "MN-1"},
-		"KMG": {"KMG", 25.1, 102.93, "Kunming", "CN", "CN-53"},
-		"XMN": {"XMN", 24.54, 118.13, "Xiamen", "CN", "CN-35"},
-		"FOC": {"FOC", 25.94, 119.66, "Fuzhou", "CN", "CN-35"},
-		"HGH": {"HGH", 30.23, 120.43, "Hangzhou", "CN", "CN-33"},
-		"TNA": {"TNA", 36.86, 117.22, "Jinan", "CN", "CN-37"},
-		"NGB": {"NGB", 29.83, 121.46, "Ningbo", "CN", "CN-33"},
-		"NKG": {"NKG", 31.74, 118.86, "Nanjing", "CN", "CN-32"},
-		"PVG": {"PVG", 31.14, 121.81, "Shanghai", "CN", "CN-31"},
-		"SHA": {"SHA", 31.2, 121.34, "Shanghai", "CN", "CN-31"},
-		"WNZ": {"WNZ", 27.91, 120.85, "Wenzhou", "CN", "CN-33"},
-		"CKG": {"CKG", 29.72, 106.64, "Chongqing", "CN", "CN-50"},
-		"KWE": {"KWE", 26.54, 106.8, "Guiyang", "CN", "CN-52"},
-		"CTU": {"CTU", 30.58, 103.95, "Chengdu", "CN", "CN-51"},
-		"URC": {"URC", 43.91, 87.47, "Ürümqi", "CN", "CN-65"},
-		"HRB": {"HRB", 45.62, 126.25, "Harbin", "CN", "CN-23"},
-		"DLC": {"DLC", 38.97, 121.54, "Dalian", "CN", "CN-21"},
-		"SHE": {"SHE", 41.64, 123.48, "Shenyang", "CN", "CN-21"},
-		"RUN": {"RUN", -20.88, 55.51, "St Denis", "RE", "RE-U-A"},
-		"EIS": {"EIS", 18.44, -64.54, "Road Town", "VG", "VG-U-A"},
-	}
+const (
+	RunwaySurfaceUnknown  RunwaySurface = ""
+	RunwaySurfaceAsphalt  RunwaySurface = "ASP"
+	RunwaySurfaceConcrete RunwaySurface = "CON"
+	RunwaySurfaceGravel   RunwaySurface = "GVL"
+	RunwaySurfaceGrass    RunwaySurface = "GRS"
 )
 
+func (s RunwaySurface) IsPaved() bool {
+	return s == RunwaySurfaceAsphalt || s == RunwaySurfaceConcrete
+}
+
 type AirportCode string
 
 const (
@@ -1251,13 +51,7 @@ const (
 )
 
 func ParseAirportCode(c string) AirportCode {
-	c = strings.ToUpper(c)
-	for _, x := range IATA_LARGE_AIRPORT_CODES {
-		if string(x) == c {
-			return AirportCode(c)
-		}
-	}
-	return AirportCodeUndefined
+	return Default().ParseAirportCode(c)
 }
 
 func (r AirportCode) IsValid() bool {
@@ -1301,8 +95,38 @@ func (c AirportCode) String() string {
 }
 
 func (r AirportCode) Airport() Airport {
-	if a, ok := IATA_LARGE_AIRPORTS[r]; ok {
-		return a
-	}
-	return Airport{}
+	a, _ := Default().Airport(r)
+	return a
+}
+
+// ElevationMeters returns the field elevation in meters. It returns false
+// when the elevation is unknown.
+func (a Airport) ElevationMeters() (float64, bool) {
+	return float64(a.Elevation) * 0.3048, a.HasElevation
+}
+
+// RunwayLengthMeters returns the length of the longest runway in meters.
+func (a Airport) RunwayLengthMeters() float64 {
+	return float64(a.RunwayLength) * 0.3048
+}
+
+// FilterAirports returns all airports for which fn returns true, sorted by
+// code.
+func FilterAirports(fn func(Airport) bool) []Airport {
+	return Default().FilterAirports(fn)
+}
+
+// AirportsWithMinRunway returns airports whose longest runway is at least
+// feet long. Airports with unknown runway length are never included.
+func AirportsWithMinRunway(feet int) []Airport {
+	return FilterAirports(func(a Airport) bool {
+		return a.RunwayLength > 0 && a.RunwayLength >= feet
+	})
+}
+
+// ScheduledAirports returns airports with scheduled airline service.
+func ScheduledAirports() []Airport {
+	return FilterAirports(func(a Airport) bool {
+		return a.Scheduled
+	})
 }
@@ -0,0 +1,111 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iata
+
+import (
+	"testing"
+
+	"github.com/echa/code/iso"
+)
+
+// withAirports installs a copy of the default registry with airports
+// added for the duration of a test.
+func withAirports(t *testing.T, list ...Airport) {
+	old := Default()
+	r, err := old.Extend(func(b *Builder) error {
+		for _, a := range list {
+			if err := b.SetAirport(a); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetDefault(r)
+	t.Cleanup(func() { SetDefault(old) })
+}
+
+func codes(list []Airport) map[AirportCode]bool {
+	m := make(map[AirportCode]bool, len(list))
+	for _, a := range list {
+		m[a.Code] = true
+	}
+	return m
+}
+
+func TestAirportsWithMinRunway(t *testing.T) {
+	withAirports(t,
+		Airport{Code: "ZZA", RunwayLength: 12000},
+		Airport{Code: "ZZB", RunwayLength: 8000},
+		Airport{Code: "ZZC", RunwayLength: 10000},
+		Airport{Code: "ZZD"}, // unknown length
+	)
+	tests := []struct {
+		feet int
+		want []AirportCode
+		not  []AirportCode
+	}{
+		{10000, []AirportCode{"ZZA", "ZZC"}, []AirportCode{"ZZB", "ZZD"}},
+		{12001, nil, []AirportCode{"ZZA", "ZZB", "ZZC", "ZZD"}},
+		{0, []AirportCode{"ZZA", "ZZB", "ZZC"}, []AirportCode{"ZZD"}},
+	}
+	for _, tt := range tests {
+		got := codes(AirportsWithMinRunway(tt.feet))
+		for _, c := range tt.want {
+			if !got[c] {
+				t.Errorf("AirportsWithMinRunway(%d): %s missing", tt.feet, c)
+			}
+		}
+		for _, c := range tt.not {
+			if got[c] {
+				t.Errorf("AirportsWithMinRunway(%d): %s included", tt.feet, c)
+			}
+		}
+	}
+}
+
+func TestScheduledAirports(t *testing.T) {
+	withAirports(t,
+		Airport{Code: "ZZA", Scheduled: true},
+		Airport{Code: "ZZB"},
+	)
+	list := ScheduledAirports()
+	got := codes(list)
+	if !got["ZZA"] || got["ZZB"] {
+		t.Errorf("ScheduledAirports() = %v", got)
+	}
+	for i := 1; i < len(list); i++ {
+		if list[i-1].Code >= list[i].Code {
+			t.Errorf("ScheduledAirports() not sorted at %s", list[i].Code)
+		}
+	}
+}
+
+func TestElevationKnown(t *testing.T) {
+	if _, ok := (Airport{Code: "ZZA"}).ElevationMeters(); ok {
+		t.Errorf("zero elevation reported as known")
+	}
+	if m, ok := (Airport{Code: "ZZA", HasElevation: true}).ElevationMeters(); !ok || m != 0 {
+		t.Errorf("sea level elevation = %v, %t", m, ok)
+	}
+	if m, ok := (Airport{Code: "ZZA", Elevation: 1000, HasElevation: true}).ElevationMeters(); !ok || m != 304.8 {
+		t.Errorf("1000ft elevation = %v, %t", m, ok)
+	}
+}
+
+func TestAirportRegions(t *testing.T) {
+	for _, a := range FilterAirports(func(Airport) bool { return true }) {
+		if a.Region == iso.RegionUndefined {
+			continue
+		}
+		if _, ok := iso.Default().RegionInfo(a.Region); !ok {
+			t.Errorf("%s: unknown region %s", a.Code, a.Region)
+		}
+		if a.Region.Country() != a.Country {
+			t.Errorf("%s: region %s not in country %s", a.Code, a.Region, a.Country)
+		}
+	}
+}
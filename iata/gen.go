@@ -0,0 +1,184 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+//go:build ignore
+// +build ignore
+
+// This program generates tables.go from the ourairports.com data snapshots
+// in the data directory. Run it with go generate. A report of added, removed
+// and changed airports is written to stdout.
+//
+// The airport snapshot was seeded from the formerly hand-maintained table
+// and uses the upstream file format, but carries only the columns that
+// table had: IATA code, type, position, country, region and municipality.
+// Its airports have no facility data. To refresh the tables, replace it
+// with a current download of airports.csv from https://ourairports.com/data/
+// and add runways.csv from the same page to include runway data.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/echa/code/internal/gen"
+	"github.com/echa/code/iso"
+)
+
+func main() {
+	airports := readCSV("data/airports.csv")
+	files := []string{"airports"}
+	runways := make(map[string]runway)
+	if _, err := os.Stat("data/runways.csv"); err == nil {
+		runways = longestRunways(readCSV("data/runways.csv"))
+		files = append(files, "runways")
+	}
+
+	var b bytes.Buffer
+	b.WriteString("package iata\n\n")
+	b.WriteString("import \"github.com/echa/code/iso\"\n\n")
+	b.WriteString("// IATA Airport codes (large airports only)\n")
+	b.WriteString("// https://ourairports.com/data/\n")
+	b.WriteString("var (\n\tIATA_LARGE_AIRPORT_CODES []AirportCode = []AirportCode{\n")
+	list := make([]map[string]string, 0)
+	seen := make(map[string]bool)
+	for _, a := range airports {
+		code := a["iata_code"]
+		if a["type"] != "large_airport" || len(code) != 3 || seen[code] {
+			continue
+		}
+		seen[code] = true
+		list = append(list, a)
+		fmt.Fprintf(&b, "\t\t%q,\n", code)
+	}
+	b.WriteString("\t}\n\n")
+
+	b.WriteString("\tIATA_LARGE_AIRPORTS = map[AirportCode]Airport{\n")
+	for _, a := range list {
+		code := a["iata_code"]
+		rwy := runways[a["id"]]
+		elev, err := strconv.Atoi(a["elevation_ft"])
+		fmt.Fprintf(&b, "\t\t%q: {%q, %s, %s, %q, %q, %q, %d, %t, %d, %q, %t},\n",
+			code, code, coord(a["latitude_deg"]), coord(a["longitude_deg"]),
+			a["municipality"], a["iso_country"], string(region(code, a["iso_region"])),
+			elev, err == nil, rwy.length, rwy.surface, a["scheduled_service"] == "yes")
+	}
+	b.WriteString("\t}\n)\n\n")
+
+	b.WriteString("// Upstream snapshots the tables were generated from, see Datasets.\n")
+	b.WriteString("var datasets = map[string]iso.Dataset{\n")
+	for _, name := range files {
+		file := "data/" + name + ".csv"
+		sum, err := gen.Checksum(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Fprintf(&b, "\t%q: {File: %q, Published: %q, Checksum: %q},\n", "OurAirports "+name, file, "seed", sum)
+	}
+	b.WriteString("}\n")
+
+	if err := gen.WriteGoFile("tables.go", b.Bytes(), os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// region returns the current ISO 3166-2 code for an OurAirports region.
+// Withdrawn codes are replaced. The placeholders OurAirports uses for
+// airports outside any subdivision like GB-U-A and its codes for Kosovo,
+// which has no ISO 3166-2 subdivisions, are dropped.
+func region(airport, s string) iso.Region {
+	if strings.HasSuffix(s, "-U-A") || strings.HasPrefix(s, "XK-") {
+		return iso.RegionUndefined
+	}
+	r := iso.ParseRegion(s)
+	if _, ok := iso.Default().RegionInfo(r); !ok {
+		log.Printf("%s: unknown region %s", airport, s)
+		return iso.RegionUndefined
+	}
+	return r
+}
+
+func readCSV(name string) []map[string]string {
+	f, err := os.Open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	recs, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		log.Fatalf("%s: %v", name, err)
+	}
+	if len(recs) == 0 {
+		return nil
+	}
+	rows := make([]map[string]string, 0, len(recs)-1)
+	for _, rec := range recs[1:] {
+		row := make(map[string]string)
+		for i, h := range recs[0] {
+			if i < len(rec) {
+				row[h] = strings.TrimSpace(rec[i])
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// coord rounds a coordinate to two decimals, which is good enough
+// to locate an airport.
+func coord(s string) string {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		log.Fatalf("invalid coordinate %q: %v", s, err)
+	}
+	f = math.Round(f*100) / 100
+	if f == 0 {
+		f = 0 // no negative zero
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+type runway struct {
+	length  int
+	surface string
+}
+
+// longestRunways returns the longest open runway per airport id.
+func longestRunways(rows []map[string]string) map[string]runway {
+	m := make(map[string]runway)
+	for _, r := range rows {
+		if r["closed"] == "1" {
+			continue
+		}
+		l, err := strconv.Atoi(r["length_ft"])
+		if err != nil {
+			continue
+		}
+		if l > m[r["airport_ref"]].length {
+			m[r["airport_ref"]] = runway{l, surface(r["surface"])}
+		}
+	}
+	return m
+}
+
+// surface maps the free-form upstream surface descriptions to the
+// RunwaySurface codes.
+func surface(s string) string {
+	s = strings.ToUpper(s)
+	switch {
+	case strings.HasPrefix(s, "ASP"), strings.HasPrefix(s, "BIT"):
+		return "ASP"
+	case strings.HasPrefix(s, "CON"), strings.HasPrefix(s, "PEM"):
+		return "CON"
+	case strings.HasPrefix(s, "GRV"), strings.HasPrefix(s, "GVL"), strings.HasPrefix(s, "GRAVEL"):
+		return "GVL"
+	case strings.HasPrefix(s, "GRS"), strings.HasPrefix(s, "GRASS"), strings.HasPrefix(s, "TURF"):
+		return "GRS"
+	}
+	return s
+}
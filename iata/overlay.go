@@ -0,0 +1,216 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iata
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/echa/code/iso"
+)
+
+// ourairports.com column names accepted as aliases in CSV overlays
+var airportFieldAliases = map[string]string{
+	"iata_code":         "code",
+	"latitude_deg":      "lat",
+	"longitude_deg":     "lon",
+	"municipality":      "name",
+	"iso_country":       "country",
+	"iso_region":        "region",
+	"elevation_ft":      "elevation",
+	"scheduled_service": "scheduled",
+}
+
+// LoadAirports adds, overrides or retires entries in the airport tables of
+// the default registry. Input is either a JSON array of objects or CSV with
+// a header row, detected from the first non-space character.
+//
+// Field names are code, type, lat, lon, name, country, region, elevation,
+// runway_length, runway_surface, scheduled and retired. CSV input may also
+// use the column names of ourairports.com's airports.csv, records without
+// IATA code are skipped. Fields missing from a record keep their current
+// value when the airport exists.
+//
+// Type uses the facility types of ourairports.com. Closed airports are
+// retired, heliports, seaplane bases and balloon ports are skipped.
+//
+// Retired airports are no longer accepted by ParseAirportCode, but their
+// data remains available from AirportCode.Airport. The overlay is applied
+// atomically, either all records are applied or none.
+func LoadAirports(r io.Reader) error {
+	br := bufio.NewReader(r)
+	var (
+		rows []map[string]string
+		err  error
+	)
+	if peek(br) == '[' {
+		rows, err = readAirportJSON(br)
+	} else {
+		rows, err = readAirportCSV(br)
+	}
+	if err != nil {
+		return err
+	}
+
+	return updateDefault(func(b *Builder) error {
+		for _, row := range rows {
+			// most ourairports.com entries have no IATA code
+			if row["code"] == "" {
+				continue
+			}
+			code := AirportCode(strings.ToUpper(row["code"]))
+			if len(code) != 3 {
+				return fmt.Errorf("iata: invalid IATA airport code '%s'", row["code"])
+			}
+			if ok, _ := parseBool(row["retired"]); ok {
+				b.RemoveAirport(code)
+				continue
+			}
+			switch t := row["type"]; t {
+			case "", "large_airport", "medium_airport", "small_airport":
+			case "closed":
+				b.RemoveAirport(code)
+				continue
+			case "heliport", "seaplane_base", "balloonport":
+				continue
+			default:
+				return fmt.Errorf("iata: airport %s: invalid type '%s'", code, t)
+			}
+			a, ok := b.Airport(code)
+			if !ok {
+				a.Code = code
+			}
+			if err := a.setFields(row); err != nil {
+				return fmt.Errorf("iata: airport %s: %v", code, err)
+			}
+			if err := b.SetAirport(a); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// peek returns the first non-space byte without consuming it.
+func peek(r *bufio.Reader) byte {
+	for {
+		b, err := r.Peek(1)
+		if err != nil {
+			return 0
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			r.ReadByte()
+		default:
+			return b[0]
+		}
+	}
+}
+
+func readAirportJSON(r io.Reader) ([]map[string]string, error) {
+	var list []map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&list); err != nil {
+		return nil, fmt.Errorf("iata: reading airports: %v", err)
+	}
+	rows := make([]map[string]string, 0, len(list))
+	for _, v := range list {
+		row := make(map[string]string, len(v))
+		for k, msg := range v {
+			var s string
+			if err := json.Unmarshal(msg, &s); err != nil {
+				s = string(msg)
+			}
+			if s != "null" {
+				row[k] = s
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func readAirportCSV(r io.Reader) ([]map[string]string, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	recs, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("iata: reading airports: %v", err)
+	}
+	if len(recs) == 0 {
+		return nil, nil
+	}
+	header := make([]string, len(recs[0]))
+	for i, h := range recs[0] {
+		h = strings.TrimSpace(h)
+		if alias, ok := airportFieldAliases[h]; ok {
+			h = alias
+		}
+		header[i] = h
+	}
+	rows := make([]map[string]string, 0, len(recs)-1)
+	for _, rec := range recs[1:] {
+		row := make(map[string]string, len(header))
+		for i, h := range header {
+			if i < len(rec) && rec[i] != "" {
+				row[h] = rec[i]
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "yes":
+		return true, nil
+	case "no", "":
+		return false, nil
+	}
+	return strconv.ParseBool(s)
+}
+
+// setFields sets airport fields from a record keyed by field name.
+func (a *Airport) setFields(row map[string]string) error {
+	var err error
+	for k, v := range row {
+		switch k {
+		case "lat":
+			a.Lat, err = strconv.ParseFloat(v, 64)
+		case "lon":
+			a.Lon, err = strconv.ParseFloat(v, 64)
+		case "name":
+			a.Name = v
+		case "country":
+			if a.Country = iso.ParseCountry(v); !a.Country.IsValid() {
+				err = fmt.Errorf("invalid country")
+			}
+		case "region":
+			// OurAirports marks airports outside any subdivision as XX-U-A
+			if strings.HasSuffix(v, "-U-A") {
+				a.Region = iso.RegionUndefined
+			} else if a.Region = iso.ParseRegion(v); !a.Region.IsValid() {
+				err = fmt.Errorf("invalid region")
+			}
+		case "elevation":
+			a.Elevation, err = strconv.Atoi(v)
+			a.HasElevation = err == nil
+		case "runway_length":
+			a.RunwayLength, err = strconv.Atoi(v)
+		case "runway_surface":
+			a.RunwaySurface = RunwaySurface(strings.ToUpper(v))
+		case "scheduled":
+			a.Scheduled, err = parseBool(v)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid %s '%s'", k, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iata
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadAirportsSkipsRowsWithoutCode(t *testing.T) {
+	// excerpt in the format of ourairports.com's airports.csv
+	in := `"id","ident","type","name","latitude_deg","longitude_deg","elevation_ft","iso_country","iso_region","municipality","scheduled_service","iata_code"
+6523,"00A","heliport","Total Rf Heliport",40.070985,-74.933689,11,"US","US-PA","Bensalem","no",""
+3632,"KLAX","large_airport","Los Angeles International Airport",33.942501,-118.407997,125,"US","US-CA","Los Angeles","yes","LAX"
+`
+	old := Default()
+	defer SetDefault(old)
+
+	if err := LoadAirports(strings.NewReader(in)); err != nil {
+		t.Fatal(err)
+	}
+	a, ok := Default().Airport("LAX")
+	if !ok || a.Elevation != 125 || !a.HasElevation {
+		t.Errorf("LAX = %+v, %t", a, ok)
+	}
+}
+
+func TestLoadAirportsFiltersType(t *testing.T) {
+	old := Default()
+	defer SetDefault(old)
+
+	in := `"id","ident","type","name","latitude_deg","longitude_deg","elevation_ft","iso_country","iso_region","municipality","scheduled_service","iata_code"
+1,"ZZA1","large_airport","A",10,10,10,"US","US-CA","A","yes","ZZA"
+2,"ZZB1","small_airport","B",10,10,10,"US","US-CA","B","no","ZZB"
+3,"ZZC1","heliport","C",10,10,10,"US","US-CA","C","no","ZZC"
+4,"ZZD1","seaplane_base","D",10,10,10,"US","US-CA","D","no","ZZD"
+5,"ZZE1","balloonport","E",10,10,10,"US","US-CA","E","no","ZZE"
+6,"ZZF1","closed","F",10,10,10,"US","US-CA","F","no","ZZF"
+7,"KTXL","closed","Berlin Tegel",52.56,13.29,122,"DE","DE-BE","Berlin","no","TXL"
+`
+	if err := LoadAirports(strings.NewReader(in)); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		code  string
+		valid bool
+	}{
+		{"ZZA", true},
+		{"ZZB", true},
+		{"ZZC", false},
+		{"ZZD", false},
+		{"ZZE", false},
+		{"ZZF", false},
+		{"TXL", false},
+	}
+	for _, tt := range tests {
+		if got := ParseAirportCode(tt.code).IsValid(); got != tt.valid {
+			t.Errorf("%s: valid = %t, want %t", tt.code, got, tt.valid)
+		}
+	}
+	if _, ok := Default().Airport("TXL"); !ok {
+		t.Errorf("TXL: data of closed airport removed")
+	}
+
+	bad := "iata_code,type\nZZG,spaceport\n"
+	if err := LoadAirports(strings.NewReader(bad)); err == nil {
+		t.Errorf("LoadAirports(%q) succeeded, want error", bad)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iata
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/echa/code/iso"
+)
+
+// Registry is an immutable set of airport tables. All lookups in this
+// package go through the default registry which is built from the generated
+// tables at init. Changing the exported tables after init has no effect on
+// lookups. Use Extend to derive a registry with changes applied and
+// SetDefault to install it.
+type Registry struct {
+	codes    map[AirportCode]bool
+	airports map[AirportCode]Airport
+}
+
+var (
+	registry   atomic.Value // *Registry
+	registryMu sync.Mutex   // serializes updates of the default registry
+)
+
+func init() {
+	registry.Store(NewRegistry())
+}
+
+// NewRegistry returns a registry built from the generated tables.
+func NewRegistry() *Registry {
+	r := &Registry{
+		codes:    make(map[AirportCode]bool, len(IATA_LARGE_AIRPORT_CODES)),
+		airports: make(map[AirportCode]Airport, len(IATA_LARGE_AIRPORTS)),
+	}
+	for _, c := range IATA_LARGE_AIRPORT_CODES {
+		r.codes[c] = true
+	}
+	for k, v := range IATA_LARGE_AIRPORTS {
+		r.airports[k] = v
+	}
+	return r
+}
+
+// Default returns the registry used by the package level functions.
+func Default() *Registry {
+	return registry.Load().(*Registry)
+}
+
+// SetDefault installs r as the registry used by the package level functions.
+func SetDefault(r *Registry) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry.Store(r)
+}
+
+// updateDefault applies fn to a copy of the default registry and installs
+// the result unless fn fails.
+func updateDefault(fn func(*Builder) error) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	r, err := Default().Extend(fn)
+	if err != nil {
+		return err
+	}
+	registry.Store(r)
+	return nil
+}
+
+// ParseAirportCode returns the airport code for c or AirportCodeUndefined
+// when the code is unknown or retired.
+func (r *Registry) ParseAirportCode(c string) AirportCode {
+	code := AirportCode(strings.ToUpper(c))
+	if r.codes[code] {
+		return code
+	}
+	return AirportCodeUndefined
+}
+
+// AirportCodes returns the sorted list of airport codes.
+func (r *Registry) AirportCodes() []AirportCode {
+	list := make([]AirportCode, 0, len(r.codes))
+	for c := range r.codes {
+		list = append(list, c)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
+
+// Airport returns the airport data for code c. Data of retired airports
+// remains available.
+func (r *Registry) Airport(c AirportCode) (Airport, bool) {
+	a, ok := r.airports[c]
+	return a, ok
+}
+
+// FilterAirports returns all airports for which fn returns true, sorted by
+// code. Retired airports are never included.
+func (r *Registry) FilterAirports(fn func(Airport) bool) []Airport {
+	list := make([]Airport, 0)
+	for c := range r.codes {
+		if a, ok := r.airports[c]; ok && fn(a) {
+			list = append(list, a)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+	return list
+}
+
+// Extend returns a copy of r with the changes made by fn applied. The
+// receiver is not modified. When fn returns an error, Extend returns
+// the error and no registry.
+func (r *Registry) Extend(fn func(*Builder) error) (*Registry, error) {
+	c := &Registry{
+		codes:    make(map[AirportCode]bool, len(r.codes)),
+		airports: make(map[AirportCode]Airport, len(r.airports)),
+	}
+	for k, v := range r.codes {
+		c.codes[k] = v
+	}
+	for k, v := range r.airports {
+		c.airports[k] = v
+	}
+	if err := fn(&Builder{r: c}); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Builder collects changes to a registry copy inside Registry.Extend.
+type Builder struct {
+	r *Registry
+}
+
+// Airport returns the current data for airport c in the registry copy.
+func (b *Builder) Airport(c AirportCode) (Airport, bool) {
+	return b.r.Airport(c)
+}
+
+// SetAirport adds airport a or replaces the data of an existing airport.
+func (b *Builder) SetAirport(a Airport) error {
+	a.Code = AirportCode(strings.ToUpper(string(a.Code)))
+	if len(a.Code) != 3 {
+		return fmt.Errorf("iata: invalid IATA airport code '%s'", a.Code)
+	}
+	b.r.codes[a.Code] = true
+	b.r.airports[a.Code] = a
+	return nil
+}
+
+// RemoveAirport removes airport code c from the list of valid codes. The
+// airport's data is kept so records referring to it can still be resolved.
+func (b *Builder) RemoveAirport(c AirportCode) {
+	delete(b.r.codes, c)
+}
+
+// Datasets returns the upstream snapshots the tables were generated from,
+// keyed by name.
+func Datasets() map[string]iso.Dataset {
+	m := make(map[string]iso.Dataset, len(datasets))
+	for k, v := range datasets {
+		m[k] = v
+	}
+	return m
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+// Package gen contains common code for the table generators in this
+// repository. Generators read upstream data snapshots checked in next to
+// the package they generate for and rewrite its tables.go file.
+package gen
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const header = `// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+// Code generated by go run gen.go; DO NOT EDIT.
+
+`
+
+// WriteGoFile formats src, prefixes it with the generated code header and
+// writes it to filename. Before overwriting, a report of added, removed and
+// changed table entries is written to w.
+func WriteGoFile(filename string, src []byte, w io.Writer) error {
+	b, err := format.Source(append([]byte(header), src...))
+	if err != nil {
+		return fmt.Errorf("gen: formatting %s: %v", filename, err)
+	}
+	old, err := os.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	Report(w, filename, ParseTables(old), ParseTables(b))
+	return os.WriteFile(filename, b, 0644)
+}
+
+// Checksum returns a short hex encoded SHA-256 hash of the contents of one
+// or more files which identifies the version of an upstream snapshot.
+func Checksum(filenames ...string) (string, error) {
+	h := sha256.New()
+	for _, name := range filenames {
+		b, err := os.ReadFile(name)
+		if err != nil {
+			return "", err
+		}
+		h.Write(b)
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8]), nil
+}
+
+var (
+	tableRx = regexp.MustCompile(`^(?:var )?\s*([A-Za-z_][A-Za-z0-9_]*)\b.*= .*\{$`)
+	entryRx = regexp.MustCompile(`^\s+"([^"]*)":?\s*(.*?),?$`)
+)
+
+// ParseTables extracts table entries from a generated Go source file. Tables
+// are keyed by variable name, entries by their quoted key. The remainder of
+// an entry line, including trailing comments, is used as the entry value.
+func ParseTables(src []byte) map[string]map[string]string {
+	tables := make(map[string]map[string]string)
+	var cur map[string]string
+	s := bufio.NewScanner(bytes.NewReader(src))
+	for s.Scan() {
+		line := s.Text()
+		if m := tableRx.FindStringSubmatch(line); m != nil {
+			cur = make(map[string]string)
+			tables[m[1]] = cur
+			continue
+		}
+		if cur == nil {
+			continue
+		}
+		if line == "}" || line == "\t}" {
+			cur = nil
+			continue
+		}
+		if m := entryRx.FindStringSubmatch(line); m != nil {
+			cur[m[1]] = strings.TrimSpace(m[2])
+		}
+	}
+	return tables
+}
+
+// Report writes the differences between two sets of tables to w.
+func Report(w io.Writer, filename string, old, new map[string]map[string]string) {
+	names := make([]string, 0, len(new))
+	for n := range new {
+		names = append(names, n)
+	}
+	for n := range old {
+		if _, ok := new[n]; !ok {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		var added, removed, changed []string
+		o, nw := old[n], new[n]
+		for k, v := range nw {
+			if ov, ok := o[k]; !ok {
+				added = append(added, k)
+			} else if ov != v {
+				changed = append(changed, k)
+			}
+		}
+		for k := range o {
+			if _, ok := nw[k]; !ok {
+				removed = append(removed, k)
+			}
+		}
+		if len(added)+len(removed)+len(changed) == 0 {
+			continue
+		}
+		sort.Strings(added)
+		sort.Strings(removed)
+		sort.Strings(changed)
+		fmt.Fprintf(w, "%s: %s: %d added, %d removed, %d changed\n",
+			filename, n, len(added), len(removed), len(changed))
+		for _, k := range added {
+			fmt.Fprintf(w, "  + %s %s\n", k, short(nw[k]))
+		}
+		for _, k := range removed {
+			fmt.Fprintf(w, "  - %s %s\n", k, short(o[k]))
+		}
+		for _, k := range changed {
+			fmt.Fprintf(w, "  ~ %s %s => %s\n", k, short(o[k]), short(nw[k]))
+		}
+	}
+}
+
+// short abbreviates long entry values like coordinate lists in reports.
+func short(v string) string {
+	if len(v) > 80 {
+		return v[:77] + "..."
+	}
+	return v
+}
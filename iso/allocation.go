@@ -0,0 +1,151 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+)
+
+// MinorUnits returns val in minor units of currency c, e.g. cents for
+// USD, rounded to the nearest unit. Amounts that do not fit into an int64
+// return an error, use ParseMinorUnits for larger amounts.
+func (c Currency) MinorUnits(val float64) (int64, error) {
+	digits := c.info().SubUnitPrecision
+	if digits <= maxFloatDigits {
+		return toMinorUnits(val, math.Pow10(digits))
+	}
+	// scaling by 10^18 in float64 loses the lower digits
+	n, ok := roundMinorUnits(val, c.SubUnitToUnit(), 1)
+	if !ok || !n.IsInt64() {
+		return 0, fmt.Errorf("iso: amount %v out of range", val)
+	}
+	if math.Signbit(val) {
+		return -n.Int64(), nil
+	}
+	return n.Int64(), nil
+}
+
+// toMinorUnits returns val times scale rounded to the nearest integer.
+func toMinorUnits(val, scale float64) (int64, error) {
+	v := math.Round(val * scale)
+	// float64(math.MaxInt64) rounds up to 2^63
+	if math.IsNaN(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return 0, fmt.Errorf("iso: amount %v out of range", val)
+	}
+	return int64(v), nil
+}
+
+// FromMinorUnits returns the amount of n minor units of currency c.
+func (c Currency) FromMinorUnits(n int64) float64 {
+	return float64(n) / math.Pow10(c.info().SubUnitPrecision)
+}
+
+// Allocate splits val into parts proportional to weights. The amount is
+// rounded to minor units of currency c first. Parts are multiples of the
+// minor unit and always sum up to the rounded amount. See AllocateMinorUnits
+// for how remainders are distributed.
+func (c Currency) Allocate(val float64, weights ...float64) ([]float64, error) {
+	return c.AllocateTo(val, PrecisionDigital, weights...)
+}
+
+// AllocateTo works like Allocate, but uses the rounding of precision p, so
+// cash allocations of CHF are multiples of 0.05.
+func (c Currency) AllocateTo(val float64, p Precision, weights ...float64) ([]float64, error) {
+	digits, increment := c.Rounding(p)
+	scale := math.Pow10(digits) / float64(increment)
+	total, err := toMinorUnits(val, scale)
+	if err != nil {
+		return nil, err
+	}
+	units, err := AllocateMinorUnits(total, weights...)
+	if err != nil {
+		return nil, err
+	}
+	parts := make([]float64, len(units))
+	for i, n := range units {
+		parts[i] = float64(n) / scale
+	}
+	return parts, nil
+}
+
+// Split splits val into n parts that differ by at most one minor unit of
+// currency c. Earlier parts receive the larger amounts.
+func (c Currency) Split(val float64, n int) ([]float64, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("iso: invalid number of parts %d", n)
+	}
+	weights := make([]float64, n)
+	for i := range weights {
+		weights[i] = 1
+	}
+	return c.Allocate(val, weights...)
+}
+
+// AllocateMinorUnits splits total minor units into parts proportional to
+// weights using the largest remainder method. Every part first receives
+// the integer part of its exact share, remaining units go one each to the
+// parts with the largest fractional shares, ties are broken in favour of
+// earlier parts. Weights may be integer ratios like 1, 2, 3 or fractions
+// like 0.25, 0.75, they must not be negative and at least one must be
+// positive. Parts of a negative total are allocated like the positive
+// total and negated. The parts always sum up to total.
+func AllocateMinorUnits(total int64, weights ...float64) ([]int64, error) {
+	if len(weights) == 0 {
+		return nil, fmt.Errorf("iso: no allocation weights")
+	}
+	w := make([]*big.Rat, len(weights))
+	sum := new(big.Rat)
+	for i, v := range weights {
+		if v < 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("iso: invalid allocation weight %v", v)
+		}
+		// use the shortest decimal form so 0.3 and 0.7 are exact
+		w[i], _ = new(big.Rat).SetString(strconv.FormatFloat(v, 'g', -1, 64))
+		sum.Add(sum, w[i])
+	}
+	if sum.Sign() == 0 {
+		return nil, fmt.Errorf("iso: allocation weights sum to zero")
+	}
+
+	neg := total < 0
+	abs := new(big.Int).SetInt64(total)
+	abs.Abs(abs)
+
+	var (
+		parts  = make([]int64, len(w))
+		rems   = make([]*big.Rat, len(w))
+		rest   = new(big.Int).Set(abs)
+		amount = new(big.Rat).SetInt(abs)
+	)
+	for i := range w {
+		share := new(big.Rat).Mul(amount, w[i])
+		share.Quo(share, sum)
+		q, r := new(big.Int).QuoRem(share.Num(), share.Denom(), new(big.Int))
+		parts[i] = q.Int64()
+		rems[i] = new(big.Rat).SetFrac(r, share.Denom())
+		rest.Sub(rest, q)
+	}
+
+	order := make([]int, len(w))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return rems[order[i]].Cmp(rems[order[j]]) > 0
+	})
+	for i, n := 0, rest.Int64(); int64(i) < n; i++ {
+		parts[order[i]]++
+	}
+
+	if neg {
+		for i := range parts {
+			parts[i] = -parts[i]
+		}
+	}
+	return parts, nil
+}
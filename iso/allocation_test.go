@@ -0,0 +1,97 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAllocateMinorUnits(t *testing.T) {
+	tests := []struct {
+		total   int64
+		weights []float64
+		want    []int64
+	}{
+		{100, []float64{1, 1, 1}, []int64{34, 33, 33}},
+		{-100, []float64{1, 1, 1}, []int64{-34, -33, -33}},
+		{5, []float64{0.3, 0.7}, []int64{2, 3}},
+		{1, []float64{1, 1}, []int64{1, 0}},
+		{101, []float64{1, 2, 3}, []int64{17, 34, 50}},
+		{10, []float64{0, 1}, []int64{0, 10}},
+		{0, []float64{1, 3}, []int64{0, 0}},
+		{math.MaxInt64, []float64{1, 1}, []int64{math.MaxInt64/2 + 1, math.MaxInt64 / 2}},
+	}
+	for _, tt := range tests {
+		got, err := AllocateMinorUnits(tt.total, tt.weights...)
+		if err != nil {
+			t.Errorf("AllocateMinorUnits(%d, %v): %v", tt.total, tt.weights, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("AllocateMinorUnits(%d, %v) = %v, want %v", tt.total, tt.weights, got, tt.want)
+		}
+	}
+}
+
+func TestAllocateMinorUnitsInvalid(t *testing.T) {
+	for _, weights := range [][]float64{
+		nil,
+		{0, 0},
+		{1, -1},
+		{math.NaN()},
+		{math.Inf(1)},
+	} {
+		if got, err := AllocateMinorUnits(100, weights...); err == nil {
+			t.Errorf("AllocateMinorUnits(100, %v) = %v, want error", weights, got)
+		}
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		code    string
+		val     float64
+		p       Precision
+		weights []float64
+		want    []float64
+	}{
+		{"USD", 100, PrecisionDigital, []float64{1, 1, 1}, []float64{33.34, 33.33, 33.33}},
+		{"USD", -0.05, PrecisionDigital, []float64{1, 1}, []float64{-0.03, -0.02}},
+		{"JPY", 100, PrecisionDigital, []float64{1, 2}, []float64{33, 67}},
+		{"CHF", 1, PrecisionCash, []float64{1, 1, 1}, []float64{0.35, 0.35, 0.3}},
+	}
+	for _, tt := range tests {
+		got, err := Currency(tt.code).AllocateTo(tt.val, tt.p, tt.weights...)
+		if err != nil {
+			t.Errorf("%s: AllocateTo(%v, %v): %v", tt.code, tt.val, tt.weights, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: AllocateTo(%v, %v) = %v, want %v", tt.code, tt.val, tt.weights, got, tt.want)
+		}
+	}
+	if got, err := Currency("USD").Split(1, 0); err == nil {
+		t.Errorf("Split(1, 0) = %v, want error", got)
+	}
+}
+
+func TestAllocateOverflow(t *testing.T) {
+	usd := Currency("USD")
+	for _, val := range []float64{1e17, -1e17, math.Inf(1), math.NaN()} {
+		if got, err := usd.Allocate(val, 1, 1); err == nil {
+			t.Errorf("Allocate(%v) = %v, want error", val, got)
+		}
+		if got, err := usd.MinorUnits(val); err == nil {
+			t.Errorf("MinorUnits(%v) = %d, want error", val, got)
+		}
+	}
+	if got, err := usd.MinorUnits(1e16); err != nil || got != 1e18 {
+		t.Errorf("MinorUnits(1e16) = %d, %v", got, err)
+	}
+	if got, err := usd.MinorUnits(-12.345); err != nil || got != -1235 {
+		t.Errorf("MinorUnits(-12.345) = %d, %v", got, err)
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Bloc identifies a named group of countries like the European Union.
+type Bloc string
+
+const (
+	BlocUndefined Bloc = ""
+	BlocEU        Bloc = "EU"
+	BlocEEA       Bloc = "EEA"
+	BlocSchengen  Bloc = "SCHENGEN"
+	BlocEurozone  Bloc = "EUROZONE"
+	BlocSEPA      Bloc = "SEPA"
+	BlocOECD      Bloc = "OECD"
+)
+
+// Membership records the period during which a country belonged to a bloc.
+// A zero To means the country is still a member.
+type Membership struct {
+	Country Country
+	From    time.Time
+	To      time.Time
+}
+
+// Covers returns true when the country was a member at time t.
+func (m Membership) Covers(t time.Time) bool {
+	return (m.From.IsZero() || !t.Before(m.From)) && (m.To.IsZero() || t.Before(m.To))
+}
+
+var bloc_names = map[Bloc]string{
+	BlocEU:       "European Union",
+	BlocEEA:      "European Economic Area",
+	BlocSchengen: "Schengen Area",
+	BlocEurozone: "Eurozone",
+	BlocSEPA:     "Single Euro Payments Area",
+	BlocOECD:     "Organisation for Economic Co-operation and Development",
+}
+
+// Bloc memberships of sovereign states. Dependent territories and
+// outermost regions with their own ISO 3166-1 code are not listed.
+// Dates are when membership took effect, for Schengen when border
+// controls were lifted, for the Eurozone when the euro was adopted
+// and for SEPA when the country joined the payment schemes.
+var bloc_members = map[Bloc][]Membership{
+	BlocEU: {
+		{"AT", date(1995, 1, 1), time.Time{}},
+		{"BE", date(1958, 1, 1), time.Time{}},
+		{"BG", date(2007, 1, 1), time.Time{}},
+		{"CY", date(2004, 5, 1), time.Time{}},
+		{"CZ", date(2004, 5, 1), time.Time{}},
+		{"DE", date(1958, 1, 1), time.Time{}},
+		{"DK", date(1973, 1, 1), time.Time{}},
+		{"EE", date(2004, 5, 1), time.Time{}},
+		{"ES", date(1986, 1, 1), time.Time{}},
+		{"FI", date(1995, 1, 1), time.Time{}},
+		{"FR", date(1958, 1, 1), time.Time{}},
+		{"GB", date(1973, 1, 1), date(2020, 2, 1)},
+		{"GR", date(1981, 1, 1), time.Time{}},
+		{"HR", date(2013, 7, 1), time.Time{}},
+		{"HU", date(2004, 5, 1), time.Time{}},
+		{"IE", date(1973, 1, 1), time.Time{}},
+		{"IT", date(1958, 1, 1), time.Time{}},
+		{"LT", date(2004, 5, 1), time.Time{}},
+		{"LU", date(1958, 1, 1), time.Time{}},
+		{"LV", date(2004, 5, 1), time.Time{}},
+		{"MT", date(2004, 5, 1), time.Time{}},
+		{"NL", date(1958, 1, 1), time.Time{}},
+		{"PL", date(2004, 5, 1), time.Time{}},
+		{"PT", date(1986, 1, 1), time.Time{}},
+		{"RO", date(2007, 1, 1), time.Time{}},
+		{"SE", date(1995, 1, 1), time.Time{}},
+		{"SI", date(2004, 5, 1), time.Time{}},
+		{"SK", date(2004, 5, 1), time.Time{}},
+	},
+	BlocEEA: {
+		{"AT", date(1994, 1, 1), time.Time{}},
+		{"BE", date(1994, 1, 1), time.Time{}},
+		{"BG", date(2007, 8, 1), time.Time{}},
+		{"CY", date(2004, 5, 1), time.Time{}},
+		{"CZ", date(2004, 5, 1), time.Time{}},
+		{"DE", date(1994, 1, 1), time.Time{}},
+		{"DK", date(1994, 1, 1), time.Time{}},
+		{"EE", date(2004, 5, 1), time.Time{}},
+		{"ES", date(1994, 1, 1), time.Time{}},
+		{"FI", date(1994, 1, 1), time.Time{}},
+		{"FR", date(1994, 1, 1), time.Time{}},
+		{"GB", date(1994, 1, 1), date(2021, 1, 1)},
+		{"GR", date(1994, 1, 1), time.Time{}},
+		{"HR", date(2014, 4, 12), time.Time{}},
+		{"HU", date(2004, 5, 1), time.Time{}},
+		{"IE", date(1994, 1, 1), time.Time{}},
+		{"IS", date(1994, 1, 1), time.Time{}},
+		{"IT", date(1994, 1, 1), time.Time{}},
+		{"LI", date(1995, 5, 1), time.Time{}},
+		{"LT", date(2004, 5, 1), time.Time{}},
+		{"LU", date(1994, 1, 1), time.Time{}},
+		{"LV", date(2004, 5, 1), time.Time{}},
+		{"MT", date(2004, 5, 1), time.Time{}},
+		{"NL", date(1994, 1, 1), time.Time{}},
+		{"NO", date(1994, 1, 1), time.Time{}},
+		{"PL", date(2004, 5, 1), time.Time{}},
+		{"PT", date(1994, 1, 1), time.Time{}},
+		{"RO", date(2007, 8, 1), time.Time{}},
+		{"SE", date(1994, 1, 1), time.Time{}},
+		{"SI", date(2004, 5, 1), time.Time{}},
+		{"SK", date(2004, 5, 1), time.Time{}},
+	},
+	BlocSchengen: {
+		{"AT", date(1997, 12, 1), time.Time{}},
+		{"BE", date(1995, 3, 26), time.Time{}},
+		{"BG", date(2024, 3, 31), time.Time{}},
+		{"CH", date(2008, 12, 12), time.Time{}},
+		{"CZ", date(2007, 12, 21), time.Time{}},
+		{"DE", date(1995, 3, 26), time.Time{}},
+		{"DK", date(2001, 3, 25), time.Time{}},
+		{"EE", date(2007, 12, 21), time.Time{}},
+		{"ES", date(1995, 3, 26), time.Time{}},
+		{"FI", date(2001, 3, 25), time.Time{}},
+		{"FR", date(1995, 3, 26), time.Time{}},
+		{"GR", date(2000, 3, 26), time.Time{}},
+		{"HR", date(2023, 1, 1), time.Time{}},
+		{"HU", date(2007, 12, 21), time.Time{}},
+		{"IS", date(2001, 3, 25), time.Time{}},
+		{"IT", date(1997, 10, 26), time.Time{}},
+		{"LI", date(2011, 12, 19), time.Time{}},
+		{"LT", date(2007, 12, 21), time.Time{}},
+		{"LU", date(1995, 3, 26), time.Time{}},
+		{"LV", date(2007, 12, 21), time.Time{}},
+		{"MT", date(2007, 12, 21), time.Time{}},
+		{"NL", date(1995, 3, 26), time.Time{}},
+		{"NO", date(2001, 3, 25), time.Time{}},
+		{"PL", date(2007, 12, 21), time.Time{}},
+		{"PT", date(1995, 3, 26), time.Time{}},
+		{"RO", date(2024, 3, 31), time.Time{}},
+		{"SE", date(2001, 3, 25), time.Time{}},
+		{"SI", date(2007, 12, 21), time.Time{}},
+		{"SK", date(2007, 12, 21), time.Time{}},
+	},
+	BlocEurozone: {
+		{"AT", date(1999, 1, 1), time.Time{}},
+		{"BE", date(1999, 1, 1), time.Time{}},
+		{"BG", date(2026, 1, 1), time.Time{}},
+		{"CY", date(2008, 1, 1), time.Time{}},
+		{"DE", date(1999, 1, 1), time.Time{}},
+		{"EE", date(2011, 1, 1), time.Time{}},
+		{"ES", date(1999, 1, 1), time.Time{}},
+		{"FI", date(1999, 1, 1), time.Time{}},
+		{"FR", date(1999, 1, 1), time.Time{}},
+		{"GR", date(2001, 1, 1), time.Time{}},
+		{"HR", date(2023, 1, 1), time.Time{}},
+		{"IE", date(1999, 1, 1), time.Time{}},
+		{"IT", date(1999, 1, 1), time.Time{}},
+		{"LT", date(2015, 1, 1), time.Time{}},
+		{"LU", date(1999, 1, 1), time.Time{}},
+		{"LV", date(2014, 1, 1), time.Time{}},
+		{"MT", date(2008, 1, 1), time.Time{}},
+		{"NL", date(1999, 1, 1), time.Time{}},
+		{"PT", date(1999, 1, 1), time.Time{}},
+		{"SI", date(2007, 1, 1), time.Time{}},
+		{"SK", date(2009, 1, 1), time.Time{}},
+	},
+	BlocSEPA: {
+		{"AD", date(2019, 3, 1), time.Time{}},
+		{"AT", date(2008, 1, 28), time.Time{}},
+		{"BE", date(2008, 1, 28), time.Time{}},
+		{"BG", date(2008, 1, 28), time.Time{}},
+		{"CH", date(2008, 1, 28), time.Time{}},
+		{"CY", date(2008, 1, 28), time.Time{}},
+		{"CZ", date(2008, 1, 28), time.Time{}},
+		{"DE", date(2008, 1, 28), time.Time{}},
+		{"DK", date(2008, 1, 28), time.Time{}},
+		{"EE", date(2008, 1, 28), time.Time{}},
+		{"ES", date(2008, 1, 28), time.Time{}},
+		{"FI", date(2008, 1, 28), time.Time{}},
+		{"FR", date(2008, 1, 28), time.Time{}},
+		{"GB", date(2008, 1, 28), time.Time{}},
+		{"GR", date(2008, 1, 28), time.Time{}},
+		{"HR", date(2013, 7, 1), time.Time{}},
+		{"HU", date(2008, 1, 28), time.Time{}},
+		{"IE", date(2008, 1, 28), time.Time{}},
+		{"IS", date(2008, 1, 28), time.Time{}},
+		{"IT", date(2008, 1, 28), time.Time{}},
+		{"LI", date(2008, 1, 28), time.Time{}},
+		{"LT", date(2008, 1, 28), time.Time{}},
+		{"LU", date(2008, 1, 28), time.Time{}},
+		{"LV", date(2008, 1, 28), time.Time{}},
+		{"MC", date(2008, 1, 28), time.Time{}},
+		{"MT", date(2008, 1, 28), time.Time{}},
+		{"NL", date(2008, 1, 28), time.Time{}},
+		{"NO", date(2008, 1, 28), time.Time{}},
+		{"PL", date(2008, 1, 28), time.Time{}},
+		{"PT", date(2008, 1, 28), time.Time{}},
+		{"RO", date(2008, 1, 28), time.Time{}},
+		{"SE", date(2008, 1, 28), time.Time{}},
+		{"SI", date(2008, 1, 28), time.Time{}},
+		{"SK", date(2008, 1, 28), time.Time{}},
+		{"SM", date(2010, 8, 1), time.Time{}},
+		{"VA", date(2019, 3, 1), time.Time{}},
+	},
+	BlocOECD: {
+		{"AT", date(1961, 9, 30), time.Time{}},
+		{"AU", date(1971, 6, 7), time.Time{}},
+		{"BE", date(1961, 9, 30), time.Time{}},
+		{"CA", date(1961, 9, 30), time.Time{}},
+		{"CH", date(1961, 9, 30), time.Time{}},
+		{"CL", date(2010, 5, 7), time.Time{}},
+		{"CO", date(2020, 4, 28), time.Time{}},
+		{"CR", date(2021, 5, 25), time.Time{}},
+		{"CZ", date(1995, 12, 21), time.Time{}},
+		{"DE", date(1961, 9, 30), time.Time{}},
+		{"DK", date(1961, 9, 30), time.Time{}},
+		{"EE", date(2010, 12, 9), time.Time{}},
+		{"ES", date(1961, 9, 30), time.Time{}},
+		{"FI", date(1969, 1, 28), time.Time{}},
+		{"FR", date(1961, 9, 30), time.Time{}},
+		{"GB", date(1961, 9, 30), time.Time{}},
+		{"GR", date(1961, 9, 30), time.Time{}},
+		{"HU", date(1996, 5, 7), time.Time{}},
+		{"IE", date(1961, 9, 30), time.Time{}},
+		{"IL", date(2010, 9, 7), time.Time{}},
+		{"IS", date(1961, 9, 30), time.Time{}},
+		{"IT", date(1962, 3, 29), time.Time{}},
+		{"JP", date(1964, 4, 28), time.Time{}},
+		{"KR", date(1996, 12, 12), time.Time{}},
+		{"LT", date(2018, 7, 5), time.Time{}},
+		{"LU", date(1961, 9, 30), time.Time{}},
+		{"LV", date(2016, 7, 1), time.Time{}},
+		{"MX", date(1994, 5, 18), time.Time{}},
+		{"NL", date(1961, 9, 30), time.Time{}},
+		{"NO", date(1961, 9, 30), time.Time{}},
+		{"NZ", date(1973, 5, 29), time.Time{}},
+		{"PL", date(1996, 11, 22), time.Time{}},
+		{"PT", date(1961, 9, 30), time.Time{}},
+		{"SE", date(1961, 9, 30), time.Time{}},
+		{"SI", date(2010, 7, 21), time.Time{}},
+		{"SK", date(2000, 12, 14), time.Time{}},
+		{"TR", date(1961, 9, 30), time.Time{}},
+		{"US", date(1961, 9, 30), time.Time{}},
+	},
+}
+
+func ParseBloc(b string) Bloc {
+	return Default().ParseBloc(b)
+}
+
+func (b Bloc) IsValid() bool {
+	return b != BlocUndefined
+}
+
+func (b Bloc) String() string {
+	if n, ok := Default().BlocName(b); ok {
+		return n
+	}
+	return string(b)
+}
+
+// Countries returns the current members of the bloc.
+func (b Bloc) Countries() CountrySet {
+	return b.CountriesAt(time.Now())
+}
+
+// CountriesAt returns the members of the bloc at time t.
+func (b Bloc) CountriesAt(t time.Time) CountrySet {
+	return Default().BlocMembers(b, t)
+}
+
+// Contains returns true when country c is a current member of the bloc.
+func (b Bloc) Contains(c Country) bool {
+	return b.ContainsAt(c, time.Now())
+}
+
+// ContainsAt returns true when country c was a member of the bloc at
+// time t.
+func (b Bloc) ContainsAt(c Country, t time.Time) bool {
+	return b.CountriesAt(t).Contains(c)
+}
+
+// Text/JSON conversion
+func (b Bloc) MarshalText() ([]byte, error) {
+	return []byte(b), nil
+}
+
+func (b *Bloc) UnmarshalText(data []byte) error {
+	bb := ParseBloc(string(data))
+	if !bb.IsValid() {
+		return fmt.Errorf("iso: invalid country bloc '%s'", string(data))
+	}
+	*b = bb
+	return nil
+}
+
+// SQL conversion
+func (b *Bloc) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		*b = ParseBloc(v)
+	case []byte:
+		*b = ParseBloc(string(v))
+	}
+	if !(*b).IsValid() {
+		return fmt.Errorf("iso: invalid country bloc '%v'", value)
+	}
+	return nil
+}
+
+func (b Bloc) Value() (driver.Value, error) {
+	return string(b), nil
+}
+
+func parseBlocCode(b string) (Bloc, error) {
+	b = strings.ToUpper(strings.TrimSpace(b))
+	if b == "" || strings.Trim(b, "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_-") != "" {
+		return BlocUndefined, fmt.Errorf("iso: invalid country bloc '%s'", b)
+	}
+	return Bloc(b), nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBlocContainsAt(t *testing.T) {
+	tests := []struct {
+		bloc Bloc
+		c    Country
+		at   time.Time
+		want bool
+	}{
+		{BlocEU, "GB", date(2020, 1, 31), true},
+		{BlocEU, "GB", date(2020, 2, 1), false},
+		{BlocEU, "GB", date(1972, 12, 31), false},
+		{BlocEU, "DE", date(2020, 2, 1), true},
+		{BlocEU, "CH", date(2020, 2, 1), false},
+		{BlocEEA, "GB", date(2020, 12, 31), true}, // transition period
+		{BlocEEA, "GB", date(2021, 1, 1), false},
+		{BlocEEA, "NO", date(2021, 1, 1), true},
+		{BlocSchengen, "CH", date(2020, 1, 1), true},
+		{BlocSchengen, "IE", date(2020, 1, 1), false},
+		{BlocEurozone, "DE", date(1998, 12, 31), false},
+		{BlocEurozone, "DE", date(1999, 1, 1), true},
+		{BlocEurozone, "SE", date(2020, 1, 1), false},
+		{BlocSEPA, "GB", date(2020, 2, 1), true},
+		{BlocOECD, "US", date(2020, 1, 1), true},
+		{BlocUndefined, "DE", date(2020, 1, 1), false},
+		{Bloc("XXX"), "DE", date(2020, 1, 1), false},
+	}
+	for _, tt := range tests {
+		if got := tt.bloc.ContainsAt(tt.c, tt.at); got != tt.want {
+			t.Errorf("%s.ContainsAt(%s, %s) = %t, want %t", string(tt.bloc), string(tt.c), tt.at.Format("2006-01-02"), got, tt.want)
+		}
+	}
+	eu := BlocEU.CountriesAt(date(2020, 2, 1))
+	if len(eu) != 27 {
+		t.Errorf("EU has %d members on 2020-02-01, want 27", len(eu))
+	}
+}
+
+func TestParseBloc(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Bloc
+		name string
+	}{
+		{"EU", BlocEU, "European Union"},
+		{"eu", BlocEU, "European Union"},
+		{"Schengen", BlocSchengen, "Schengen Area"},
+		{"SEPA", BlocSEPA, "Single Euro Payments Area"},
+		{"XXX", BlocUndefined, ""},
+		{"", BlocUndefined, ""},
+	}
+	for _, tt := range tests {
+		got := ParseBloc(tt.in)
+		if got != tt.want {
+			t.Errorf("ParseBloc(%q) = %q, want %q", tt.in, string(got), string(tt.want))
+			continue
+		}
+		if got.IsValid() && got.String() != tt.name {
+			t.Errorf("%s: String() = %q, want %q", string(got), got.String(), tt.name)
+		}
+	}
+
+	var b Bloc
+	if err := b.UnmarshalText([]byte("eea")); err != nil || b != BlocEEA {
+		t.Errorf("UnmarshalText(eea) = %q, %v", string(b), err)
+	}
+	if err := b.Scan("XXX"); err == nil {
+		t.Errorf("Scan(XXX): expected error")
+	}
+}
+
+func TestSetBloc(t *testing.T) {
+	old := Default()
+	r, err := old.Extend(func(b *Builder) error {
+		return b.SetBloc("dach", "German-speaking countries", []Membership{
+			{"AT", time.Time{}, time.Time{}},
+			{"CH", time.Time{}, time.Time{}},
+			{"DE", time.Time{}, time.Time{}},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetDefault(r)
+	defer SetDefault(old)
+
+	dach := ParseBloc("DACH")
+	if !dach.IsValid() || dach.String() != "German-speaking countries" {
+		t.Fatalf("ParseBloc(DACH) = %q", string(dach))
+	}
+	if got, want := dach.Countries().String(), "AT,CH,DE"; got != want {
+		t.Errorf("DACH countries = %q, want %q", got, want)
+	}
+	if got, want := dach.Countries().Intersect(BlocEU.Countries()).String(), "AT,DE"; got != want {
+		t.Errorf("DACH in EU = %q, want %q", got, want)
+	}
+	if old.ParseBloc("DACH").IsValid() {
+		t.Errorf("Extend modified the original registry")
+	}
+
+	for _, code := range []string{"", "D A", "ÄÖ"} {
+		_, err := old.Extend(func(b *Builder) error {
+			return b.SetBloc(code, "x", nil)
+		})
+		if err == nil {
+			t.Errorf("SetBloc(%q): expected error", code)
+		}
+	}
+	_, err = old.Extend(func(b *Builder) error {
+		return b.SetBloc("X", "x", []Membership{{CountryUndefined, time.Time{}, time.Time{}}})
+	})
+	if err == nil {
+		t.Errorf("SetBloc with undefined country: expected error")
+	}
+}
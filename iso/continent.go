@@ -0,0 +1,169 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// Continent codes
+type Continent string
+
+const (
+	ContinentUndefined    Continent = ""
+	ContinentAfrica       Continent = "AF"
+	ContinentAntarctica   Continent = "AN"
+	ContinentAsia         Continent = "AS"
+	ContinentEurope       Continent = "EU"
+	ContinentNorthAmerica Continent = "NA"
+	ContinentOceania      Continent = "OC"
+	ContinentSouthAmerica Continent = "SA"
+)
+
+var continent_names = map[Continent]string{
+	ContinentAfrica:       "Africa",
+	ContinentAntarctica:   "Antarctica",
+	ContinentAsia:         "Asia",
+	ContinentEurope:       "Europe",
+	ContinentNorthAmerica: "North America",
+	ContinentOceania:      "Oceania",
+	ContinentSouthAmerica: "South America",
+}
+
+func ParseContinent(c string) Continent {
+	c = strings.ToUpper(c)
+	if _, ok := continent_names[Continent(c)]; ok {
+		return Continent(c)
+	}
+	for k, v := range continent_names {
+		if strings.EqualFold(v, c) {
+			return k
+		}
+	}
+	return ContinentUndefined
+}
+
+func (c Continent) IsValid() bool {
+	return c != ContinentUndefined
+}
+
+func (c Continent) String() string {
+	if n, ok := continent_names[c]; ok {
+		return n
+	}
+	return string(c)
+}
+
+// Text/JSON conversion
+func (c Continent) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+func (c *Continent) UnmarshalText(data []byte) error {
+	cc := ParseContinent(string(data))
+	if !cc.IsValid() {
+		return fmt.Errorf("iso: invalid continent code '%s'", string(data))
+	}
+	*c = cc
+	return nil
+}
+
+// SQL conversion
+func (c *Continent) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		*c = ParseContinent(v)
+	case []byte:
+		*c = ParseContinent(string(v))
+	}
+	if !(*c).IsValid() {
+		return fmt.Errorf("iso: invalid continent code '%v'", value)
+	}
+	return nil
+}
+
+func (c Continent) Value() (driver.Value, error) {
+	return string(c), nil
+}
+
+// UN M.49 three-digit region code
+type UNRegion string
+
+const (
+	UNRegionUndefined UNRegion = ""
+	UNRegionWorld     UNRegion = "001"
+)
+
+func ParseUNRegion(c string) UNRegion {
+	if _, ok := un_region_names[c]; ok {
+		return UNRegion(c)
+	}
+	return UNRegionUndefined
+}
+
+func (r UNRegion) IsValid() bool {
+	return r != UNRegionUndefined
+}
+
+func (r UNRegion) String() string {
+	if n, ok := un_region_names[string(r)]; ok {
+		return n
+	}
+	return string(r)
+}
+
+// Countries returns all countries within region r, including countries in
+// its sub-regions.
+func (r UNRegion) Countries() []Country {
+	list := make([]Country, 0)
+	if !r.IsValid() {
+		return list
+	}
+	reg := Default()
+	for _, c := range reg.countryCodes {
+		info, ok := reg.CountryInfo(Country(c))
+		if !ok {
+			continue
+		}
+		switch r {
+		case UNRegionWorld, info.UNRegion, info.UNSubRegion, info.UNIntermediateRegion:
+			list = append(list, Country(c))
+		}
+	}
+	return list
+}
+
+// Text/JSON conversion
+func (r UNRegion) MarshalText() ([]byte, error) {
+	return []byte(r), nil
+}
+
+func (r *UNRegion) UnmarshalText(data []byte) error {
+	rr := ParseUNRegion(string(data))
+	if !rr.IsValid() {
+		return fmt.Errorf("iso: invalid UN M.49 region code '%s'", string(data))
+	}
+	*r = rr
+	return nil
+}
+
+// SQL conversion
+func (r *UNRegion) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		*r = ParseUNRegion(v)
+	case []byte:
+		*r = ParseUNRegion(string(v))
+	}
+	if !(*r).IsValid() {
+		return fmt.Errorf("iso: invalid UN M.49 region code '%v'", value)
+	}
+	return nil
+}
+
+func (r UNRegion) Value() (driver.Value, error) {
+	return string(r), nil
+}
@@ -3,780 +3,40 @@
 
 package iso
 
+//go:generate go run gen.go
+
 import (
 	"database/sql/driver"
 	"fmt"
-	"strings"
+	"time"
 )
 
+// Deprecated: CountryGPS and CountryNames hold the built-in tables, changes
+// are not seen by lookups. Use Default().CountryGPS and Default().CountryName.
 var (
 	CountryGPS   = country_gps
 	CountryNames = country_names
 )
 
-// ISO 3166-1 Alpha-2 country codes.
-// https://en.wikipedia.org/wiki/ISO_3166-1
-// https://gist.github.com/unceus/6501985
-var (
-	ISO_3166_1_COUNTRY_CODES []string = []string{
-		"AF",
-		"AX",
-		"AL",
-		"DZ",
-		"AS",
-		"AD",
-		"AO",
-		"AI",
-		"AQ",
-		"AG",
-		"AR",
-		"AM",
-		"AW",
-		"AU",
-		"AT",
-		"AZ",
-		"BS",
-		"BH",
-		"BD",
-		"BB",
-		"BY",
-		"BE",
-		"BZ",
-		"BJ",
-		"BM",
-		"BT",
-		"BO",
-		"BA",
-		"BW",
-		"BV",
-		"BR",
-		"IO",
-		"BN",
-		"BG",
-		"BF",
-		"BI",
-		"KH",
-		"CM",
-		"CA",
-		"CV",
-		"KY",
-		"CF",
-		"TD",
-		"CL",
-		"CN",
-		"CX",
-		"CC",
-		"CO",
-		"KM",
-		"CG",
-		"CD",
-		"CK",
-		"CR",
-		"CI",
-		"HR",
-		"CU",
-		"CY",
-		"CZ",
-		"DK",
-		"DJ",
-		"DM",
-		"DO",
-		"EC",
-		"EG",
-		"SV",
-		"GQ",
-		"ER",
-		"EE",
-		"ET",
-		"FK",
-		"FO",
-		"FJ",
-		"FI",
-		"FR",
-		"GF",
-		"PF",
-		"TF",
-		"GA",
-		"GM",
-		"GE",
-		"DE",
-		"GH",
-		"GI",
-		"GR",
-		"GL",
-		"GD",
-		"GP",
-		"GU",
-		"GT",
-		"GG",
-		"GN",
-		"GW",
-		"GY",
-		"HT",
-		"HM",
-		"VA",
-		"HN",
-		"HK",
-		"HU",
-		"IS",
-		"IN",
-		"ID",
-		"IR",
-		"IQ",
-		"IE",
-		"IM",
-		"IL",
-		"IT",
-		"JM",
-		"JP",
-		"JE",
-		"JO",
-		"KZ",
-		"KE",
-		"KI",
-		"KP",
-		"KR",
-		"KW",
-		"KG",
-		"LA",
-		"LV",
-		"LB",
-		"LS",
-		"LR",
-		"LY",
-		"LI",
-		"LT",
-		"LU",
-		"ME",
-		"MO",
-		"MK",
-		"MG",
-		"MW",
-		"MY",
-		"MV",
-		"ML",
-		"MT",
-		"MH",
-		"MQ",
-		"MR",
-		"MU",
-		"YT",
-		"MX",
-		"FM",
-		"MD",
-		"MC",
-		"MN",
-		"MS",
-		"MA",
-		"MZ",
-		"MM",
-		"NA",
-		"NR",
-		"NP",
-		"NL",
-		"NC",
-		"NZ",
-		"NI",
-		"NE",
-		"NG",
-		"NU",
-		"NF",
-		"MP",
-		"NO",
-		"OM",
-		"PK",
-		"PW",
-		"PS",
-		"PA",
-		"PG",
-		"PY",
-		"PE",
-		"PH",
-		"PN",
-		"PL",
-		"PT",
-		"PR",
-		"QA",
-		"RE",
-		"RO",
-		"RS",
-		"RU",
-		"RW",
-		"SH",
-		"KN",
-		"LC",
-		"PM",
-		"VC",
-		"WS",
-		"SM",
-		"ST",
-		"SA",
-		"SN",
-		"SC",
-		"SL",
-		"SG",
-		"SK",
-		"SI",
-		"SB",
-		"SO",
-		"SX",
-		"ZA",
-		"GS",
-		"ES",
-		"LK",
-		"SD",
-		"SR",
-		"SJ",
-		"SZ",
-		"SE",
-		"CH",
-		"SY",
-		"TW",
-		"TJ",
-		"TZ",
-		"TH",
-		"TL",
-		"TG",
-		"TK",
-		"TO",
-		"TT",
-		"TN",
-		"TR",
-		"TM",
-		"TC",
-		"TV",
-		"UG",
-		"UA",
-		"AE",
-		"GB",
-		"US",
-		"UM",
-		"UY",
-		"UZ",
-		"VU",
-		"VE",
-		"VN",
-		"VG",
-		"VI",
-		"WF",
-		"EH",
-		"XK",
-		"YE",
-		"ZM",
-		"ZW",
-	}
-)
-
-var country_names = map[string]string{
-	"AF": "Afghanistan",
-	"AX": "Åland Islands",
-	"AL": "Albania",
-	"DZ": "Algeria",
-	"AS": "American Samoa",
-	"AD": "Andorra",
-	"AO": "Angola",
-	"AI": "Anguilla",
-	"AQ": "Antarctica",
-	"AG": "Antigua and Barbuda",
-	"AR": "Argentina",
-	"AM": "Armenia",
-	"AW": "Aruba",
-	"AU": "Australia",
-	"AT": "Austria",
-	"AZ": "Azerbaijan",
-	"BS": "Bahamas",
-	"BH": "Bahrain",
-	"BD": "Bangladesh",
-	"BB": "Barbados",
-	"BY": "Belarus",
-	"BE": "Belgium",
-	"BZ": "Belize",
-	"BJ": "Benin",
-	"BM": "Bermuda",
-	"BT": "Bhutan",
-	"BO": "Bolivia",
-	"BA": "Bosnia and Herzegovina",
-	"BW": "Botswana",
-	"BV": "Bouvet Island",
-	"BR": "Brazil",
-	"IO": "British Indian Ocean Territory",
-	"BN": "Brunei Darussalam",
-	"BG": "Bulgaria",
-	"BF": "Burkina Faso",
-	"BI": "Burundi",
-	"KH": "Cambodia",
-	"CM": "Cameroon",
-	"CA": "Canada",
-	"CV": "Cape Verde",
-	"KY": "Cayman Islands",
-	"CF": "Central African Republic",
-	"TD": "Chad",
-	"CL": "Chile",
-	"CN": "China",
-	"CX": "Christmas Island",
-	"CC": "Cocos (Keeling) Islands",
-	"CO": "Colombia",
-	"KM": "Comoros",
-	"CG": "Congo",
-	"CD": "Congo, The Democratic Republic of the",
-	"CK": "Cook Islands",
-	"CR": "Costa Rica",
-	"CI": "Cote D'Ivoire",
-	"HR": "Croatia",
-	"CU": "Cuba",
-	"CY": "Cyprus",
-	"CZ": "Czech Republic",
-	"DK": "Denmark",
-	"DJ": "Djibouti",
-	"DM": "Dominica",
-	"DO": "Dominican Republic",
-	"EC": "Ecuador",
-	"EG": "Egypt",
-	"SV": "El Salvador",
-	"GQ": "Equatorial Guinea",
-	"ER": "Eritrea",
-	"EE": "Estonia",
-	"ET": "Ethiopia",
-	"FK": "Falkland Islands (Malvinas)",
-	"FO": "Faroe Islands",
-	"FJ": "Fiji",
-	"FI": "Finland",
-	"FR": "France",
-	"GF": "French Guiana",
-	"PF": "French Polynesia",
-	"TF": "French Southern Territories",
-	"GA": "Gabon",
-	"GM": "Gambia",
-	"GE": "Georgia",
-	"DE": "Germany",
-	"GH": "Ghana",
-	"GI": "Gibraltar",
-	"GR": "Greece",
-	"GL": "Greenland",
-	"GD": "Grenada",
-	"GP": "Guadeloupe",
-	"GU": "Guam",
-	"GT": "Guatemala",
-	"GG": "Guernsey",
-	"GN": "Guinea",
-	"GW": "Guinea-Bissau",
-	"GY": "Guyana",
-	"HT": "Haiti",
-	"HM": "Heard Island and Mcdonald Islands",
-	"VA": "Holy See (Vatican City State)",
-	"HN": "Honduras",
-	"HK": "Hong Kong",
-	"HU": "Hungary",
-	"IS": "Iceland",
-	"IN": "India",
-	"ID": "Indonesia",
-	"IR": "Iran, Islamic Republic Of",
-	"IQ": "Iraq",
-	"IE": "Ireland",
-	"IM": "Isle of Man",
-	"IL": "Israel",
-	"IT": "Italy",
-	"JM": "Jamaica",
-	"JP": "Japan",
-	"JE": "Jersey",
-	"JO": "Jordan",
-	"KZ": "Kazakhstan",
-	"KE": "Kenya",
-	"KI": "Kiribati",
-	"KP": "Korea, Democratic People's Republic of",
-	"KR": "Korea, Republic of",
-	"KW": "Kuwait",
-	"KG": "Kyrgyzstan",
-	"LA": "Lao People's Democratic Republic",
-	"LV": "Latvia",
-	"LB": "Lebanon",
-	"LS": "Lesotho",
-	"LR": "Liberia",
-	"LY": "Libyan Arab Jamahiriya",
-	"LI": "Liechtenstein",
-	"LT": "Lithuania",
-	"LU": "Luxembourg",
-	"MO": "Macao",
-	"MK": "Macedonia, The Former Yugoslav Republic of",
-	"MG": "Madagascar",
-	"MW": "Malawi",
-	"MY": "Malaysia",
-	"MV": "Maldives",
-	"ML": "Mali",
-	"MT": "Malta",
-	"MH": "Marshall Islands",
-	"MQ": "Martinique",
-	"MR": "Mauritania",
-	"MU": "Mauritius",
-	"YT": "Mayotte",
-	"MX": "Mexico",
-	"FM": "Micronesia, Federated States of",
-	"MD": "Moldova, Republic of",
-	"MC": "Monaco",
-	"ME": "Montenegro",
-	"MN": "Mongolia",
-	"MS": "Montserrat",
-	"MA": "Morocco",
-	"MZ": "Mozambique",
-	"MM": "Myanmar",
-	"NA": "Namibia",
-	"NR": "Nauru",
-	"NP": "Nepal",
-	"NL": "Netherlands",
-	"NC": "New Caledonia",
-	"NZ": "New Zealand",
-	"NI": "Nicaragua",
-	"NE": "Niger",
-	"NG": "Nigeria",
-	"NU": "Niue",
-	"NF": "Norfolk Island",
-	"MP": "Northern Mariana Islands",
-	"NO": "Norway",
-	"OM": "Oman",
-	"PK": "Pakistan",
-	"PW": "Palau",
-	"PS": "Palestinian Territory, Occupied",
-	"PA": "Panama",
-	"PG": "Papua New Guinea",
-	"PY": "Paraguay",
-	"PE": "Peru",
-	"PH": "Philippines",
-	"PN": "Pitcairn",
-	"PL": "Poland",
-	"PT": "Portugal",
-	"PR": "Puerto Rico",
-	"QA": "Qatar",
-	"RE": "Reunion",
-	"RO": "Romania",
-	"RS": "Serbia",
-	"RU": "Russian Federation",
-	"RW": "Rwanda",
-	"SH": "Saint Helena",
-	"SX": "Sint Maarten (Dutch part)",
-	"KN": "Saint Kitts and Nevis",
-	"LC": "Saint Lucia",
-	"PM": "Saint Pierre and Miquelon",
-	"VC": "Saint Vincent and the Grenadines",
-	"WS": "Samoa",
-	"SM": "San Marino",
-	"ST": "Sao Tome and Principe",
-	"SA": "Saudi Arabia",
-	"SN": "Senegal",
-	"SC": "Seychelles",
-	"SL": "Sierra Leone",
-	"SG": "Singapore",
-	"SK": "Slovakia",
-	"SI": "Slovenia",
-	"SB": "Solomon Islands",
-	"SO": "Somalia",
-	"ZA": "South Africa",
-	"GS": "South Georgia and the South Sandwich Islands",
-	"ES": "Spain",
-	"LK": "Sri Lanka",
-	"SD": "Sudan",
-	"SR": "Suriname",
-	"SJ": "Svalbard and Jan Mayen",
-	"SZ": "Swaziland",
-	"SE": "Sweden",
-	"CH": "Switzerland",
-	"SY": "Syrian Arab Republic",
-	"TW": "Taiwan, Province of China",
-	"TJ": "Tajikistan",
-	"TZ": "Tanzania, United Republic of",
-	"TH": "Thailand",
-	"TL": "Timor-Leste",
-	"TG": "Togo",
-	"TK": "Tokelau",
-	"TO": "Tonga",
-	"TT": "Trinidad and Tobago",
-	"TN": "Tunisia",
-	"TR": "Turkey",
-	"TM": "Turkmenistan",
-	"TC": "Turks and Caicos Islands",
-	"TV": "Tuvalu",
-	"UG": "Uganda",
-	"UA": "Ukraine",
-	"AE": "United Arab Emirates",
-	"GB": "United Kingdom",
-	"US": "United States",
-	"UM": "United States Minor Outlying Islands",
-	"UY": "Uruguay",
-	"UZ": "Uzbekistan",
-	"VU": "Vanuatu",
-	"VE": "Venezuela",
-	"VN": "Vietnam",
-	"VG": "Virgin Islands, British",
-	"VI": "Virgin Islands, U.S.",
-	"WF": "Wallis and Futuna",
-	"EH": "Western Sahara",
-	"XK": "Kosovo",
-	"YE": "Yemen",
-	"ZM": "Zambia",
-	"ZW": "Zimbabwe",
-}
+type Country string
 
-var country_gps = map[string][2]float64{
-	"AD": {42.546245, 1.601554},
-	"AE": {23.424076, 53.847818},
-	"AF": {33.93911, 67.709953},
-	"AG": {17.060816, -61.796428},
-	"AI": {18.220554, -63.068615},
-	"AL": {41.153332, 20.168331},
-	"AM": {40.069099, 45.038189},
-	"AO": {-11.202692, 17.873887},
-	"AQ": {-75.250973, -0.071389},
-	"AR": {-38.416097, -63.616672},
-	"AS": {-14.270972, -170.132217},
-	"AT": {47.516231, 14.550072},
-	"AU": {-25.274398, 133.775136},
-	"AW": {12.52111, -69.968338},
-	"AX": {60.24889, 19.618190},
-	"AZ": {40.143105, 47.576927},
-	"BA": {43.915886, 17.679076},
-	"BB": {13.193887, -59.543198},
-	"BD": {23.684994, 90.356331},
-	"BE": {50.503887, 4.469936},
-	"BF": {12.238333, -1.561593},
-	"BG": {42.733883, 25.48583},
-	"BH": {25.930414, 50.637772},
-	"BI": {-3.373056, 29.918886},
-	"BJ": {9.30769, 2.315834},
-	"BM": {32.321384, -64.75737},
-	"BN": {4.535277, 114.727669},
-	"BO": {-16.290154, -63.588653},
-	"BR": {-14.235004, -51.92528},
-	"BS": {25.03428, -77.39628},
-	"BT": {27.514162, 90.433601},
-	"BV": {-54.423199, 3.413194},
-	"BW": {-22.328474, 24.684866},
-	"BY": {53.709807, 27.953389},
-	"BZ": {17.189877, -88.49765},
-	"CA": {56.130366, -106.346771},
-	"CC": {-12.164165, 96.870956},
-	"CD": {-4.038333, 21.758664},
-	"CF": {6.611111, 20.939444},
-	"CG": {-0.228021, 15.827659},
-	"CH": {46.818188, 8.227512},
-	"CI": {7.539989, -5.54708},
-	"CK": {-21.236736, -159.777671},
-	"CL": {-35.675147, -71.542969},
-	"CM": {7.369722, 12.354722},
-	"CN": {35.86166, 104.195397},
-	"CO": {4.570868, -74.297333},
-	"CR": {9.748917, -83.753428},
-	"CU": {21.521757, -77.781167},
-	"CV": {16.002082, -24.013197},
-	"CX": {-10.447525, 105.690449},
-	"CY": {35.126413, 33.429859},
-	"CZ": {49.817492, 15.472962},
-	"DE": {51.165691, 10.451526},
-	"DJ": {11.825138, 42.590275},
-	"DK": {56.26392, 9.501785},
-	"DM": {15.414999, -61.370976},
-	"DO": {18.735693, -70.162651},
-	"DZ": {28.033886, 1.659626},
-	"EC": {-1.831239, -78.183406},
-	"EE": {58.595272, 25.013607},
-	"EG": {26.820553, 30.802498},
-	"EH": {24.215527, -12.885834},
-	"ER": {15.179384, 39.782334},
-	"ES": {40.463667, -3.74922},
-	"ET": {9.145, 40.489673},
-	"FI": {61.92411, 25.748151},
-	"FJ": {-16.578193, 179.414413},
-	"FK": {-51.796253, -59.523613},
-	"FM": {7.425554, 150.550812},
-	"FO": {61.892635, -6.911806},
-	"FR": {46.227638, 2.213749},
-	"GA": {-0.803689, 11.609444},
-	"GB": {55.378051, -3.435973},
-	"GD": {12.262776, -61.604171},
-	"GE": {42.315407, 43.356892},
-	"GF": {3.933889, -53.125782},
-	"GG": {49.465691, -2.585278},
-	"GH": {7.946527, -1.023194},
-	"GI": {36.137741, -5.345374},
-	"GL": {71.706936, -42.604303},
-	"GM": {13.443182, -15.310139},
-	"GN": {9.945587, -9.696645},
-	"GP": {16.995971, -62.067641},
-	"GQ": {1.650801, 10.267895},
-	"GR": {39.074208, 21.824312},
-	"GS": {-54.429579, -36.587909},
-	"GT": {15.783471, -90.230759},
-	"GU": {13.444304, 144.793731},
-	"GW": {11.803749, -15.180413},
-	"GY": {4.860416, -58.93018},
-	"HK": {22.396428, 114.109497},
-	"HM": {-53.08181, 73.504158},
-	"HN": {15.199999, -86.241905},
-	"HR": {45.1, 15.2},
-	"HT": {18.971187, -72.285215},
-	"HU": {47.162494, 19.503304},
-	"ID": {-0.789275, 113.921327},
-	"IE": {53.41291, -8.24389},
-	"IL": {31.046051, 34.851612},
-	"IM": {54.236107, -4.548056},
-	"IN": {20.593684, 78.96288},
-	"IO": {-6.343194, 71.876519},
-	"IQ": {33.223191, 43.679291},
-	"IR": {32.427908, 53.688046},
-	"IS": {64.963051, -19.020835},
-	"IT": {41.87194, 12.56738},
-	"JE": {49.214439, -2.13125},
-	"JM": {18.109581, -77.297508},
-	"JO": {30.585164, 36.238414},
-	"JP": {36.204824, 138.252924},
-	"KE": {-0.023559, 37.906193},
-	"KG": {41.20438, 74.766098},
-	"KH": {12.565679, 104.990963},
-	"KI": {-3.370417, -168.734039},
-	"KM": {-11.875001, 43.872219},
-	"KN": {17.357822, -62.782998},
-	"KP": {40.339852, 127.510093},
-	"KR": {35.907757, 127.766922},
-	"KW": {29.31166, 47.481766},
-	"KY": {19.513469, -80.566956},
-	"KZ": {48.019573, 66.923684},
-	"LA": {19.85627, 102.495496},
-	"LB": {33.854721, 35.862285},
-	"LC": {13.909444, -60.978893},
-	"LI": {47.166, 9.555373},
-	"LK": {7.873054, 80.771797},
-	"LR": {6.428055, -9.429499},
-	"LS": {-29.609988, 28.233608},
-	"LT": {55.169438, 23.881275},
-	"LU": {49.815273, 6.129583},
-	"LV": {56.879635, 24.603189},
-	"LY": {26.3351, 17.228331},
-	"MA": {31.791702, -7.09262},
-	"MC": {43.750298, 7.412841},
-	"MD": {47.411631, 28.369885},
-	"ME": {42.708678, 19.37439},
-	"MG": {-18.766947, 46.869107},
-	"MH": {7.131474, 171.184478},
-	"MK": {41.608635, 21.745275},
-	"ML": {17.570692, -3.996166},
-	"MM": {21.913965, 95.956223},
-	"MN": {46.862496, 103.846656},
-	"MO": {22.198745, 113.543873},
-	"MP": {17.33083, 145.38469},
-	"MQ": {14.641528, -61.024174},
-	"MR": {21.00789, -10.940835},
-	"MS": {16.742498, -62.187366},
-	"MT": {35.937496, 14.375416},
-	"MU": {-20.348404, 57.552152},
-	"MV": {3.202778, 73.22068},
-	"MW": {-13.254308, 34.301525},
-	"MX": {23.634501, -102.552784},
-	"MY": {4.210484, 101.975766},
-	"MZ": {-18.665695, 35.529562},
-	"NA": {-22.95764, 18.49041},
-	"NC": {-20.904305, 165.618042},
-	"NE": {17.607789, 8.081666},
-	"NF": {-29.040835, 167.954712},
-	"NG": {9.081999, 8.675277},
-	"NI": {12.865416, -85.207229},
-	"NL": {52.132633, 5.291266},
-	"NO": {60.472024, 8.468946},
-	"NP": {28.394857, 84.124008},
-	"NR": {-0.522778, 166.931503},
-	"NU": {-19.054445, -169.867233},
-	"NZ": {-40.900557, 174.885971},
-	"OM": {21.512583, 55.923255},
-	"PA": {8.537981, -80.782127},
-	"PE": {-9.189967, -75.015152},
-	"PF": {-17.679742, -149.406843},
-	"PG": {-6.314993, 143.95555},
-	"PH": {12.879721, 121.774017},
-	"PK": {30.375321, 69.345116},
-	"PL": {51.919438, 19.145136},
-	"PM": {46.941936, -56.27111},
-	"PN": {-24.703615, -127.439308},
-	"PR": {18.220833, -66.590149},
-	"PS": {31.952162, 35.233154},
-	"PT": {39.399872, -8.224454},
-	"PW": {7.51498, 134.58252},
-	"PY": {-23.442503, -58.443832},
-	"QA": {25.354826, 51.183884},
-	"RE": {-21.115141, 55.536384},
-	"RO": {45.943161, 24.96676},
-	"RS": {44.016521, 21.005859},
-	"RU": {61.52401, 105.318756},
-	"RW": {-1.940278, 29.873888},
-	"SA": {23.885942, 45.079162},
-	"SB": {-9.64571, 160.156194},
-	"SC": {-4.679574, 55.491977},
-	"SD": {12.862807, 30.217636},
-	"SE": {60.128161, 18.643501},
-	"SG": {1.352083, 103.819836},
-	"SH": {-24.143474, -10.030696},
-	"SI": {46.151241, 14.995463},
-	"SJ": {77.553604, 23.670272},
-	"SK": {48.669026, 19.699024},
-	"SL": {8.460555, -11.779889},
-	"SM": {43.94236, 12.457777},
-	"SN": {14.497401, -14.452362},
-	"SO": {5.152149, 46.199616},
-	"SR": {3.919305, -56.027783},
-	"ST": {0.18636, 6.613081},
-	"SV": {13.794185, -88.89653},
-	"SX": {18.029252, -63.100299},
-	"SY": {34.802075, 38.996815},
-	"SZ": {-26.522503, 31.465866},
-	"TC": {21.694025, -71.797928},
-	"TD": {15.454166, 18.732207},
-	"TF": {-49.280366, 69.348557},
-	"TG": {8.619543, 0.824782},
-	"TH": {15.870032, 100.992541},
-	"TJ": {38.861034, 71.276093},
-	"TK": {-8.967363, -171.855881},
-	"TL": {-8.874217, 125.727539},
-	"TM": {38.969719, 59.556278},
-	"TN": {33.886917, 9.537499},
-	"TO": {-21.178986, -175.198242},
-	"TR": {38.963745, 35.243322},
-	"TT": {10.691803, -61.222503},
-	"TV": {-7.109535, 177.64933},
-	"TW": {23.69781, 120.960515},
-	"TZ": {-6.369028, 34.888822},
-	"UA": {48.379433, 31.16558},
-	"UG": {1.373333, 32.290275},
-	"US": {37.09024, -95.712891},
-	"UY": {-32.522779, -55.765835},
-	"UZ": {41.377491, 64.585262},
-	"UM": {15.141761, 169.634012},
-	"VA": {41.902916, 12.453389},
-	"VC": {12.984305, -61.287228},
-	"VE": {6.42375, -66.58973},
-	"VG": {18.420695, -64.639968},
-	"VI": {18.335765, -64.896335},
-	"VN": {14.058324, 108.277199},
-	"VU": {-15.376706, 166.959158},
-	"WF": {-13.768752, -177.156097},
-	"WS": {-13.759029, -172.104629},
-	"XK": {42.602636, 20.902977},
-	"YE": {15.552727, 48.516388},
-	"YT": {-12.8275, 45.166244},
-	"ZA": {-30.559482, 22.937506},
-	"ZM": {-13.133897, 27.849332},
-	"ZW": {-19.015438, 29.154857},
+// CountryInfo holds country metadata from REST Countries and UN M.49.
+type CountryInfo struct {
+	CallingCodes         []string  // E.164 dialling prefixes, e.g. "+49" or "+1876"
+	TLD                  string    // country code top-level domain, e.g. ".de"
+	Capital              string    // English name of the capital
+	Continent            Continent // continent
+	UNRegion             UNRegion  // UN M.49 region, e.g. 150 Europe
+	UNSubRegion          UNRegion  // UN M.49 sub-region, e.g. 155 Western Europe
+	UNIntermediateRegion UNRegion  // UN M.49 intermediate region, may be empty
 }
 
-type Country string
-
 const (
 	CountryUndefined Country = ""
 )
 
 func ParseCountry(c string) Country {
-	c = strings.ToUpper(c)
-	for _, x := range ISO_3166_1_COUNTRY_CODES {
-		if x == c {
-			return Country(c)
-		}
-	}
-	return CountryUndefined
+	return Default().ParseCountry(c)
 }
 
 func (r Country) IsValid() bool {
@@ -816,15 +76,114 @@ func (r Country) Value() (driver.Value, error) {
 }
 
 func (c Country) String() string {
-	if n, ok := country_names[string(c)]; ok {
+	r := Default()
+	if n, ok := r.CountryName(c); ok {
+		return n
+	}
+	if n, ok := historicName(string(c), r.countryHistory); ok {
 		return n
 	}
+	if f, ok := r.formerCountry(string(c), time.Time{}); ok {
+		return f.Name
+	}
+	if v, ok := country_reservations[string(c)]; ok {
+		return v.Name
+	}
 	return string(c)
 }
 
 func (c Country) GPS() (float64, float64, bool) {
-	if gps, ok := country_gps[string(c)]; ok {
-		return gps[0], gps[1], true
+	return Default().CountryGPS(c)
+}
+
+// BoundingBox returns the country's bounding box. Countries without
+// coordinate data return false.
+func (c Country) BoundingBox() (BoundingBox, bool) {
+	return Default().CountryBounds(c)
+}
+
+// Contains returns true when the point at lat, lon lies within the
+// country's simplified boundary or, for small states without boundary,
+// within its bounding box.
+func (c Country) Contains(lat, lon float64) bool {
+	return Default().CountryContains(c, lat, lon)
+}
+
+// SubdivisionType returns the most common category of the country's top
+// level subdivisions, e.g. "State" or "Prefecture".
+func (c Country) SubdivisionType() string {
+	return Default().SubdivisionType(c)
+}
+
+// NameIn returns the country's name in language l as defined by CLDR.
+// When no translation exists, the English name is returned.
+func (c Country) NameIn(l Language) string {
+	if n, ok := Default().CountryNameIn(c, l); ok {
+		return n
 	}
-	return 0, 0, false
+	return c.ShortName()
+}
+
+// ShortName returns the country's common English short name, e.g. "South
+// Korea" instead of the ISO name "Korea, Republic of".
+func (c Country) ShortName() string {
+	if n, ok := Default().CountryNameIn(c, LanguageEnglish); ok {
+		return n
+	}
+	return c.String()
+}
+
+// OfficialName returns the country's official long form English name, e.g.
+// "Federal Republic of Germany". Countries without a distinct official name
+// return their ISO name.
+func (c Country) OfficialName() string {
+	if n, ok := Default().CountryOfficialName(c); ok {
+		return n
+	}
+	return c.String()
+}
+
+// Info returns the country's metadata.
+func (c Country) Info() CountryInfo {
+	info, _ := Default().CountryInfo(c)
+	return info
+}
+
+// CallingCodes returns the country's E.164 dialling prefixes. Smaller
+// members of shared zones like the North American Numbering Plan return
+// the prefix including their area codes, e.g. "+1876" for Jamaica. The
+// US, Canada, Russia and Kazakhstan have too many area codes to list and
+// return the bare country code "+1" or "+7".
+func (c Country) CallingCodes() []string {
+	return c.Info().CallingCodes
+}
+
+// Languages returns the country's official languages.
+func (c Country) Languages() []Language {
+	return Default().CountryLanguages(c)
+}
+
+// TLD returns the country's top-level domain including the leading dot.
+func (c Country) TLD() string {
+	return c.Info().TLD
+}
+
+// Capital returns the English name of the country's capital.
+func (c Country) Capital() string {
+	return c.Info().Capital
+}
+
+// Continent returns the continent the country is located on.
+func (c Country) Continent() Continent {
+	return c.Info().Continent
+}
+
+// UNRegion returns the country's UN M.49 region.
+func (c Country) UNRegion() UNRegion {
+	return c.Info().UNRegion
+}
+
+// UNSubRegion returns the country's UN M.49 sub-region.
+func (c Country) UNSubRegion() UNRegion {
+	return c.Info().UNSubRegion
 }
@@ -0,0 +1,81 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"testing"
+)
+
+func TestCountryNameIn(t *testing.T) {
+	tests := []struct {
+		code Country
+		lang Language
+		want string
+	}{
+		{"DE", LanguageEnglish, "Germany"},
+		{"DE", "deu", "Deutschland"},
+		{"DE", "jpn", "ドイツ"},
+		{"KR", "deu", "Südkorea"},
+		{"KR", "jpn", "韓国"},
+		{"KR", "swa", "South Korea"}, // no translation, English name
+		{"KR", LanguageUndefined, "South Korea"},
+		{"XX", "deu", "XX"},
+	}
+	for _, tt := range tests {
+		if got := tt.code.NameIn(tt.lang); got != tt.want {
+			t.Errorf("%s: NameIn(%s) = %q, want %q", tt.code, tt.lang, got, tt.want)
+		}
+	}
+}
+
+func TestCountryNames(t *testing.T) {
+	tests := []struct {
+		code     Country
+		name     string // String
+		short    string // ShortName
+		official string // OfficialName
+	}{
+		{"DE", "Germany", "Germany", "Federal Republic of Germany"},
+		{"FR", "France", "France", "French Republic"},
+		{"KR", "Korea, Republic of", "South Korea", "Korea, Republic of"}, // no official name in iso-codes
+		{"GB", "United Kingdom", "United Kingdom", "United Kingdom of Great Britain and Northern Ireland"},
+		{"CA", "Canada", "Canada", "Canada"}, // no distinct official name
+		{"XX", "XX", "XX", "XX"},
+	}
+	for _, tt := range tests {
+		if got := tt.code.String(); got != tt.name {
+			t.Errorf("%s: String() = %q, want %q", tt.code, got, tt.name)
+		}
+		if got := tt.code.ShortName(); got != tt.short {
+			t.Errorf("%s: ShortName() = %q, want %q", tt.code, got, tt.short)
+		}
+		if got := tt.code.OfficialName(); got != tt.official {
+			t.Errorf("%s: OfficialName() = %q, want %q", tt.code, got, tt.official)
+		}
+	}
+}
+
+func TestCountryNameInExtend(t *testing.T) {
+	old := Default()
+	r, err := old.Extend(func(b *Builder) error {
+		b.SetCountryNameIn("DE", "swa", "Ujerumani")
+		b.SetCountryOfficialName("CA", "Dominion of Canada")
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetDefault(r)
+	defer SetDefault(old)
+
+	if got := Country("DE").NameIn("swa"); got != "Ujerumani" {
+		t.Errorf("DE: NameIn(swa) = %q, want %q", got, "Ujerumani")
+	}
+	if got := Country("CA").OfficialName(); got != "Dominion of Canada" {
+		t.Errorf("CA: OfficialName() = %q, want %q", got, "Dominion of Canada")
+	}
+	if _, ok := old.CountryNameIn("DE", "swa"); ok {
+		t.Errorf("Extend modified the original registry")
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CountrySet is a sorted list of distinct countries. The zero value is an
+// empty set. Set operations return new sets and never modify their inputs.
+type CountrySet []Country
+
+// NewCountrySet returns a set of the defined countries in list.
+func NewCountrySet(list ...Country) CountrySet {
+	s := make(CountrySet, 0, len(list))
+	for _, c := range list {
+		if c.IsValid() {
+			s = append(s, c)
+		}
+	}
+	sort.Slice(s, func(i, j int) bool { return s[i] < s[j] })
+	n := 0
+	for i, c := range s {
+		if i == 0 || c != s[n-1] {
+			s[n] = c
+			n++
+		}
+	}
+	return s[:n]
+}
+
+// parseCountrySet parses a comma separated list of country codes. Curly
+// braces around the list as used by PostgreSQL arrays are ignored.
+func parseCountrySet(s string) (CountrySet, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "{"), "}")
+	if s == "" {
+		return CountrySet{}, nil
+	}
+	fields := strings.Split(s, ",")
+	list := make([]Country, len(fields))
+	for i, f := range fields {
+		f = strings.Trim(strings.TrimSpace(f), `"`)
+		if list[i] = ParseCountry(f); !list[i].IsValid() {
+			return nil, fmt.Errorf("iso: invalid ISO 3166-1 alpha-2 country code '%s'", f)
+		}
+	}
+	return NewCountrySet(list...), nil
+}
+
+// Contains returns true when country c is in the set.
+func (s CountrySet) Contains(c Country) bool {
+	i := sort.Search(len(s), func(i int) bool { return s[i] >= c })
+	return i < len(s) && s[i] == c
+}
+
+// Equal returns true when both sets contain the same countries.
+func (s CountrySet) Equal(o CountrySet) bool {
+	if len(s) != len(o) {
+		return false
+	}
+	for i := range s {
+		if s[i] != o[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Union returns the countries in s or o.
+func (s CountrySet) Union(o CountrySet) CountrySet {
+	u := make([]Country, 0, len(s)+len(o))
+	u = append(u, s...)
+	return NewCountrySet(append(u, o...)...)
+}
+
+// Intersect returns the countries in both s and o.
+func (s CountrySet) Intersect(o CountrySet) CountrySet {
+	x := make(CountrySet, 0)
+	for _, c := range s {
+		if o.Contains(c) {
+			x = append(x, c)
+		}
+	}
+	return x
+}
+
+// Difference returns the countries in s which are not in o.
+func (s CountrySet) Difference(o CountrySet) CountrySet {
+	d := make(CountrySet, 0)
+	for _, c := range s {
+		if !o.Contains(c) {
+			d = append(d, c)
+		}
+	}
+	return d
+}
+
+// String returns the comma separated list of country codes.
+func (s CountrySet) String() string {
+	codes := make([]string, len(s))
+	for i, c := range s {
+		codes[i] = string(c)
+	}
+	return strings.Join(codes, ",")
+}
+
+// Text conversion
+func (s CountrySet) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *CountrySet) UnmarshalText(data []byte) error {
+	ss, err := parseCountrySet(string(data))
+	if err != nil {
+		return err
+	}
+	*s = ss
+	return nil
+}
+
+// JSON conversion uses an array of country codes
+func (s CountrySet) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]Country(NewCountrySet(s...)))
+}
+
+func (s *CountrySet) UnmarshalJSON(data []byte) error {
+	var list []Country
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*s = NewCountrySet(list...)
+	return nil
+}
+
+// SQL conversion
+func (s *CountrySet) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*s = CountrySet{}
+		return nil
+	case string:
+		return s.UnmarshalText([]byte(v))
+	case []byte:
+		return s.UnmarshalText(v)
+	}
+	return fmt.Errorf("iso: invalid country set '%v'", value)
+}
+
+func (s CountrySet) Value() (driver.Value, error) {
+	return s.String(), nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewCountrySet(t *testing.T) {
+	tests := []struct {
+		in   []Country
+		want string
+	}{
+		{nil, ""},
+		{[]Country{"DE"}, "DE"},
+		{[]Country{"FR", "DE", "AT"}, "AT,DE,FR"},
+		{[]Country{"DE", "FR", "DE", CountryUndefined}, "DE,FR"},
+	}
+	for _, tt := range tests {
+		if got := NewCountrySet(tt.in...).String(); got != tt.want {
+			t.Errorf("NewCountrySet(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCountrySetAlgebra(t *testing.T) {
+	tests := []struct {
+		a, b      CountrySet
+		union     string
+		intersect string
+		diff      string
+	}{
+		{NewCountrySet("AT", "DE", "FR"), NewCountrySet("CH", "DE"), "AT,CH,DE,FR", "DE", "AT,FR"},
+		{NewCountrySet("AT", "DE"), NewCountrySet("AT", "DE"), "AT,DE", "AT,DE", ""},
+		{NewCountrySet("AT"), NewCountrySet("CH"), "AT,CH", "", "AT"},
+		{NewCountrySet("AT"), nil, "AT", "", "AT"},
+		{nil, NewCountrySet("AT"), "AT", "", ""},
+		{nil, nil, "", "", ""},
+	}
+	for _, tt := range tests {
+		a, b := tt.a.String(), tt.b.String()
+		if got := tt.a.Union(tt.b).String(); got != tt.union {
+			t.Errorf("%q.Union(%q) = %q, want %q", a, b, got, tt.union)
+		}
+		if got := tt.a.Intersect(tt.b).String(); got != tt.intersect {
+			t.Errorf("%q.Intersect(%q) = %q, want %q", a, b, got, tt.intersect)
+		}
+		if got := tt.a.Difference(tt.b).String(); got != tt.diff {
+			t.Errorf("%q.Difference(%q) = %q, want %q", a, b, got, tt.diff)
+		}
+		if tt.a.String() != a || tt.b.String() != b {
+			t.Errorf("set operations modified their inputs %q, %q", a, b)
+		}
+	}
+}
+
+func TestCountrySetContains(t *testing.T) {
+	s := NewCountrySet("AT", "DE", "FR")
+	tests := []struct {
+		c    Country
+		want bool
+	}{
+		{"AT", true},
+		{"DE", true},
+		{"FR", true},
+		{"CH", false},
+		{"ZZ", false},
+		{CountryUndefined, false},
+	}
+	for _, tt := range tests {
+		if got := s.Contains(tt.c); got != tt.want {
+			t.Errorf("Contains(%q) = %t, want %t", string(tt.c), got, tt.want)
+		}
+	}
+	if !s.Equal(NewCountrySet("FR", "AT", "DE")) || s.Equal(NewCountrySet("AT", "DE")) {
+		t.Errorf("Equal compares sets incorrectly")
+	}
+}
+
+func TestCountrySetJSON(t *testing.T) {
+	buf, err := json.Marshal(NewCountrySet("FR", "DE"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(buf), `["DE","FR"]`; got != want {
+		t.Errorf("MarshalJSON = %s, want %s", got, want)
+	}
+	var s CountrySet
+	if err := json.Unmarshal([]byte(`["fr","DE","de"]`), &s); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.String(), "DE,FR"; got != want {
+		t.Errorf("UnmarshalJSON = %q, want %q", got, want)
+	}
+	if err := json.Unmarshal([]byte(`["DE","XX"]`), &s); err == nil {
+		t.Errorf("UnmarshalJSON with invalid code: expected error")
+	}
+}
+
+func TestCountrySetScan(t *testing.T) {
+	tests := []struct {
+		in   interface{}
+		want string
+		ok   bool
+	}{
+		{nil, "", true},
+		{"", "", true},
+		{"DE,FR", "DE,FR", true},
+		{" fr , de ", "DE,FR", true},
+		{[]byte("{DE,FR}"), "DE,FR", true},
+		{`{"DE","FR"}`, "DE,FR", true},
+		{"DE,XX", "", false},
+		{"DE,,FR", "", false},
+		{42, "", false},
+	}
+	for _, tt := range tests {
+		var s CountrySet
+		err := s.Scan(tt.in)
+		if (err == nil) != tt.ok {
+			t.Errorf("Scan(%v): error %v, want ok=%t", tt.in, err, tt.ok)
+			continue
+		}
+		if got := s.String(); tt.ok && got != tt.want {
+			t.Errorf("Scan(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+	v, err := NewCountrySet("FR", "DE").Value()
+	if err != nil || v != "DE,FR" {
+		t.Errorf("Value = %v, %v, want DE,FR", v, err)
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFormatSpecialCurrencies(t *testing.T) {
+	tests := []struct {
+		code     string
+		category CurrencyCategory
+		want     string
+	}{
+		// funds use the minor unit of their ISO entry without a sub unit name
+		{"BOV", CurrencyCategoryFund, "1,234.57BOV"},
+		{"CHE", CurrencyCategoryFund, "1,234.57CHE"},
+		{"CHW", CurrencyCategoryFund, "1,234.57CHW"},
+		{"CLF", CurrencyCategoryFund, "UF1.234,5678"},
+		{"COU", CurrencyCategoryFund, "1,234.57COU"},
+		{"MXV", CurrencyCategoryFund, "1,234.57MXV"},
+		{"USN", CurrencyCategoryFund, "1,234.57USN"},
+		{"UYI", CurrencyCategoryFund, "1,235UYI"},
+		// metals, units and test codes have no minor unit
+		{"XAG", CurrencyCategoryMetal, "1,235oz t"},
+		{"XAU", CurrencyCategoryMetal, "1,235oz t"},
+		{"XPD", CurrencyCategoryMetal, "1,235XPD"},
+		{"XPT", CurrencyCategoryMetal, "1,235XPT"},
+		{"XBA", CurrencyCategoryUnit, "1,235XBA"},
+		{"XBB", CurrencyCategoryUnit, "1,235XBB"},
+		{"XBC", CurrencyCategoryUnit, "1,235XBC"},
+		{"XBD", CurrencyCategoryUnit, "1,235XBD"},
+		{"XDR", CurrencyCategoryUnit, "1,235SDR"},
+		{"XSU", CurrencyCategoryUnit, "1,235XSU"},
+		{"XUA", CurrencyCategoryUnit, "1,235XUA"},
+		{"XTS", CurrencyCategoryTest, "1,235XTS"},
+		{"XXX", CurrencyCategoryNone, "1,235XXX"},
+	}
+	for _, tt := range tests {
+		c := ParseCurrency(tt.code)
+		if !c.IsValid() {
+			t.Errorf("%s: not a valid currency", tt.code)
+			continue
+		}
+		if got := c.Category(); got != tt.category {
+			t.Errorf("%s: category %q, want %q", tt.code, got, tt.category)
+		}
+		if got := c.Format(1234.5678, nil); got != tt.want {
+			t.Errorf("%s: Format(1234.5678) = %q, want %q", tt.code, got, tt.want)
+		}
+		if got := c.Format(-1234.5678, nil); got != "-"+tt.want {
+			t.Errorf("%s: Format(-1234.5678) = %q, want %q", tt.code, got, "-"+tt.want)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	opts := NewCurrencyOptions
+	tests := []struct {
+		code string
+		val  float64
+		opts *CurrencyOptions
+		want string
+	}{
+		{"USD", 1234.5, nil, "$1,234.50"},
+		{"USD", -1234.5, nil, "-$1,234.50"},
+		{"USD", 0.125, nil, "$0.13"},
+		{"USD", -0.125, nil, "-$0.13"},
+		{"USD", -0.001, nil, "$0.00"},
+		{"USD", 12.5, opts().Plus(true), "+$12.50"},
+		{"USD", -12.5, opts().Style(CurrencyStyleAccounting), "($12.50)"},
+		{"USD", -12.5, opts().Sign(SignTrailing), "$12.50-"},
+		{"USD", -12.5, opts().Symbol(false), "-12.50"},
+		{"USD", 12.5, opts().Currency(true), "$12.50 USD"},
+		{"USD", 1250, opts().Style(CurrencyStyleCompact), "$1.3K"},
+		{"EUR", 1234.5, nil, "1.234,50€"},
+		{"EUR", -1234.5, nil, "-1.234,50€"},
+		{"EUR", 2.345, nil, "2,35€"},
+		{"GBP", -0.5, nil, "-£0.50"},
+		{"JPY", 2.5, nil, "¥3"},
+		{"JPY", -2.5, nil, "-¥3"},
+		{"JPY", 3.5, nil, "¥4"},
+		{"KWD", 0.0125, nil, "د.ك0.013"},
+		{"SEK", 12.5, opts().Cash(true), "13kr"},
+		{"CHF", 12.53, opts().Cash(true), "CHF 12.55"},
+		{"CHF", 1234.5, nil, "CHF 1,234.50"},
+		{"CHF", -12.5, nil, "CHF-12.50"},
+		{"CHF", -12.5, opts().Sign(SignBeforeSymbol), "-CHF 12.50"},
+		{"CHF", -12.5, opts().Style(CurrencyStyleAccounting), "(CHF 12.50)"},
+		{"ANG", -12.5, nil, "NAf. -12,50"},
+		{"AWG", -12.5, nil, "Afl. -12.50"},
+		{"SRD", -12.5, nil, "$ -12.50"},
+		{"VES", -1234.567, nil, "Bs-1.234,57"},
+		{"VED", 1234.567, nil, "Bs.D 1.234,57"},
+		{"MRU", -1234.567, nil, "-1,234.57 UM"},
+		{"SLE", 1234.567, nil, "Le 1,234.57"},
+		{"STN", 1234.567, nil, "1,234.57 Db"},
+		{"ZWG", -1234.567, nil, "-ZiG 1,234.57"},
+		{"UYW", 1234.5, nil, "UP 1.234,5000"},
+		{"XCG", -12.5, nil, "Cg -12,50"},
+		{"BYN", 1234.5, nil, "1 234,50 Br"},
+	}
+	for _, tt := range tests {
+		if got := Currency(tt.code).Format(tt.val, tt.opts); got != tt.want {
+			t.Errorf("%s: Format(%v) = %q, want %q", tt.code, tt.val, got, tt.want)
+		}
+	}
+}
+
+func TestFormatLocale(t *testing.T) {
+	tests := []struct {
+		code   string
+		locale string
+		want   [2]string // positive, negative
+	}{
+		{"EUR", "de", [2]string{"1.234,50 €", "-1.234,50 €"}},
+		{"EUR", "de-DE", [2]string{"1.234,50 €", "-1.234,50 €"}},
+		{"EUR", "de-AT", [2]string{"€ 1.234,50", "-€ 1.234,50"}},
+		{"EUR", "nl", [2]string{"€ 1.234,50", "€ -1.234,50"}},
+		{"EUR", "nl_NL", [2]string{"€ 1.234,50", "€ -1.234,50"}},
+		{"EUR", "en", [2]string{"€1.234,50", "-€1.234,50"}},
+		{"CHF", "de-CH", [2]string{"CHF 1,234.50", "CHF-1,234.50"}},
+		{"CHF", "fr-CH", [2]string{"1,234.50 CHF", "-1,234.50 CHF"}},
+		{"CHF", "en", [2]string{"CHF 1,234.50", "-CHF 1,234.50"}},
+		{"CHF", "xx", [2]string{"CHF 1,234.50", "CHF-1,234.50"}},
+		{"USD", "ja", [2]string{"$1,234.50", "-$1,234.50"}},
+		{"BRL", "pt", [2]string{"R$ 1.234,50", "-R$ 1.234,50"}},
+	}
+	for _, tt := range tests {
+		c := Currency(tt.code)
+		for i, val := range []float64{1234.5, -1234.5} {
+			if got := c.Format(val, NewCurrencyOptions().In(tt.locale)); got != tt.want[i] {
+				t.Errorf("%s in %s: Format(%v) = %q, want %q", tt.code, tt.locale, val, got, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestFormatRoundsLikeRound(t *testing.T) {
+	opts := NewCurrencyOptions().Symbol(false).Separator(false)
+	for _, code := range []string{"USD", "JPY", "KWD", "CLF", "CHF", "SEK"} {
+		c := Currency(code)
+		dec := c.Decimals()
+		for i := -2000; i <= 2000; i++ {
+			val := float64(i) / 1000
+			want := strconv.FormatFloat(c.Round(val), 'f', dec, 64)
+			if c.Round(val) == 0 {
+				want = strings.TrimPrefix(want, "-")
+			}
+			got := c.Format(val, opts)
+			got = strings.Replace(got, c.info().DecimalMark, ".", 1)
+			if got != want {
+				t.Errorf("%s: Format(%v) = %q, Round = %q", code, val, got, want)
+			}
+		}
+	}
+}
+
+func TestCurrencyRounding(t *testing.T) {
+	tests := []struct {
+		code      Currency
+		p         Precision
+		digits    int
+		increment int
+	}{
+		{"USD", PrecisionDigital, 2, 1},
+		{"USD", PrecisionCash, 2, 1},
+		{"CHF", PrecisionDigital, 2, 1},
+		{"CHF", PrecisionCash, 2, 5},
+		{"SEK", PrecisionDigital, 2, 1},
+		{"SEK", PrecisionCash, 0, 1},
+		{"CZK", PrecisionCash, 0, 1},
+		{"HUF", PrecisionCash, 0, 1},
+		{"DKK", PrecisionCash, 2, 50},
+		{"JPY", PrecisionDigital, 0, 1},
+		{"JPY", PrecisionCash, 0, 1},
+		{"KRW", PrecisionCash, 0, 1},
+		{"ISK", PrecisionDigital, 0, 1},
+		{"KWD", PrecisionDigital, 3, 1},
+	}
+	for _, tt := range tests {
+		digits, increment := tt.code.Rounding(tt.p)
+		if digits != tt.digits || increment != tt.increment {
+			t.Errorf("%s: Rounding(%d) = %d, %d, want %d, %d", tt.code, tt.p, digits, increment, tt.digits, tt.increment)
+		}
+	}
+}
+
+func TestRoundTo(t *testing.T) {
+	tests := []struct {
+		code Currency
+		val  float64
+		p    Precision
+		want float64
+	}{
+		{"CHF", 12.53, PrecisionDigital, 12.53},
+		{"CHF", 12.53, PrecisionCash, 12.55},
+		{"CHF", 12.52, PrecisionCash, 12.5},
+		{"CHF", 12.575, PrecisionCash, 12.6},
+		{"CHF", -12.525, PrecisionCash, -12.55}, // halves away from zero
+		{"SEK", 12.49, PrecisionDigital, 12.49},
+		{"SEK", 12.49, PrecisionCash, 12},
+		{"SEK", 12.5, PrecisionCash, 13},
+		{"DKK", 12.24, PrecisionCash, 12},
+		{"DKK", 12.25, PrecisionCash, 12.5},
+		{"JPY", 1234.5, PrecisionDigital, 1235},
+		{"JPY", 1234.4, PrecisionCash, 1234},
+		{"USD", 1.006, PrecisionCash, 1.01},
+	}
+	for _, tt := range tests {
+		if got := tt.code.RoundTo(tt.val, tt.p); got != tt.want {
+			t.Errorf("%s: RoundTo(%v, %d) = %v, want %v", tt.code, tt.val, tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestCurrencyFormattingData(t *testing.T) {
+	for _, code := range Default().CurrencyCodes() {
+		c := Currency(code)
+		if c.IsCustom() || c.Category() != CurrencyCategoryLegalTender {
+			continue
+		}
+		// the generator falls back to the code as symbol and no subunit
+		cc, ok := Default().currency(c)
+		if !ok || (cc.Symbol == code && cc.SubUnit == "") {
+			t.Errorf("%s: no formatting data", code)
+		}
+	}
+}
+
+func TestCurrencyCodes(t *testing.T) {
+	for _, code := range []string{"VES", "VED", "MRU", "STN", "SLE", "ZWG", "UYW", "XCG", "BYN", "EUR"} {
+		if !ParseCurrency(code).IsValid() {
+			t.Errorf("%s: not a valid currency", code)
+		}
+	}
+	// withdrawn codes keep parsing but are marked historic
+	for _, code := range []string{"HRK", "BYR", "MRO", "STD", "VEF", "SLL", "ZWL", "CUC", "ANG", "DEM"} {
+		c := ParseCurrency(code)
+		if !c.IsValid() {
+			t.Errorf("%s: withdrawn currency not parsed", code)
+		}
+		if !c.IsHistoric() {
+			t.Errorf("%s: withdrawn currency not historic", code)
+		}
+		var v Currency
+		if err := v.UnmarshalText([]byte(code)); err != nil || v != c {
+			t.Errorf("%s: UnmarshalText = %q, %v", code, v, err)
+		}
+		if err := v.Scan(code); err != nil || v != c {
+			t.Errorf("%s: Scan = %q, %v", code, v, err)
+		}
+	}
+	for _, code := range []string{"EUR", "VES", "XCG"} {
+		if Currency(code).IsHistoric() {
+			t.Errorf("%s: current currency marked historic", code)
+		}
+	}
+	if ParseCurrency("QQQ").IsValid() {
+		t.Errorf("QQQ: unknown currency parsed")
+	}
+	r, err := Default().Extend(func(b *Builder) error {
+		b.RemoveCurrency("USD")
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.ParseCurrency("USD").IsValid() || !r.IsHistoricCurrency("USD") {
+		t.Errorf("USD: retired currency not parsed as historic")
+	}
+	for _, code := range r.CurrencyCodes() {
+		if code == "USD" {
+			t.Errorf("USD: retired currency still listed")
+		}
+	}
+	numbers := map[string]int{
+		"BOV": 984, "CHE": 947, "CHW": 948, "COU": 970, "MXV": 979, "USN": 997,
+		"UYI": 940, "UYW": 927, "BYN": 933, "VES": 928, "XCG": 532, "ZWG": 924, "XXX": 999,
+	}
+	for code, want := range numbers {
+		if got := Currency(code).info().IsoNumeric; got != want {
+			t.Errorf("%s: numeric code %d, want %d", code, got, want)
+		}
+	}
+	if got := Currency("UYW").Decimals(); got != 4 {
+		t.Errorf("UYW: %d decimals, want 4", got)
+	}
+}
@@ -0,0 +1,339 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CLDR standard currency patterns by locale. ¤ stands for the symbol and
+// # or 0 for the number, patterns without a negative part after ; write
+// negative amounts with a minus sign before the positive pattern.
+// https://cldr.unicode.org (numbers.json, currencyFormats-numberSystem-latn)
+var currency_locale_patterns = map[string]string{
+	"ar":     "#,##0.00 ¤",
+	"cs":     "#,##0.00 ¤",
+	"da":     "#,##0.00 ¤",
+	"de":     "#,##0.00 ¤",
+	"de-AT":  "¤ #,##0.00",
+	"de-CH":  "¤ #,##0.00;¤-#,##0.00",
+	"de-LI":  "¤ #,##0.00",
+	"el":     "#,##0.00 ¤",
+	"en":     "¤#,##0.00",
+	"en-AT":  "¤ #,##0.00",
+	"en-CH":  "¤ #,##0.00;¤-#,##0.00",
+	"en-DE":  "#,##0.00 ¤",
+	"en-NL":  "¤ #,##0.00;¤ -#,##0.00",
+	"es":     "#,##0.00 ¤",
+	"es-419": "¤#,##0.00",
+	"es-MX":  "¤#,##0.00",
+	"es-US":  "¤#,##0.00",
+	"es-UY":  "¤ #,##0.00;¤ -#,##0.00",
+	"es-VE":  "¤#,##0.00;¤-#,##0.00",
+	"fi":     "#,##0.00 ¤",
+	"fr":     "#,##0.00 ¤",
+	"fr-CH":  "#,##0.00 ¤;-#,##0.00 ¤",
+	"fy":     "¤ #,##0.00;¤ -#,##0.00",
+	"he":     "#,##0.00 ¤",
+	"hi":     "¤#,##0.00",
+	"hr":     "#,##0.00 ¤",
+	"hu":     "#,##0.00 ¤",
+	"id":     "¤#,##0.00",
+	"it":     "#,##0.00 ¤",
+	"it-CH":  "¤ #,##0.00;¤-#,##0.00",
+	"ja":     "¤#,##0.00",
+	"ko":     "¤#,##0.00",
+	"nl":     "¤ #,##0.00;¤ -#,##0.00",
+	"pl":     "#,##0.00 ¤",
+	"pt":     "¤ #,##0.00",
+	"pt-PT":  "#,##0.00 ¤",
+	"pt-ST":  "#,##0.00 ¤",
+	"ro":     "#,##0.00 ¤",
+	"ru":     "#,##0.00 ¤",
+	"sk":     "#,##0.00 ¤",
+	"sv":     "#,##0.00 ¤",
+	"th":     "¤#,##0.00",
+	"tr":     "¤#,##0.00",
+	"uk":     "#,##0.00 ¤",
+	"vi":     "#,##0.00 ¤",
+	"zh":     "¤#,##0.00",
+}
+
+// Locales whose pattern is used when no locale is selected, for currencies
+// whose sign or symbol is not customarily written like in English.
+var currency_locales = map[string]string{
+	"ANG": "nl-CW",
+	"AWG": "nl-AW",
+	"BYN": "ru-BY",
+	"CHF": "de-CH",
+	"MRU": "ar-MR",
+	"SLE": "en-SL",
+	"SRD": "nl-SR",
+	"STN": "pt-ST",
+	"UYW": "es-UY",
+	"VED": "es-VE",
+	"VES": "es-VE",
+	"XCG": "nl-CW",
+	"ZWG": "en-ZW",
+}
+
+// CLDR symbols that differ from the default symbol of a currency, keyed by
+// currency and locale, language or nothing for all locales.
+var currency_locale_symbols = map[string]string{
+	"ANG:nl": "NAf.",
+	"AWG:nl": "Afl.",
+	"CHF:":   "CHF",
+}
+
+// currencyPattern is a parsed CLDR currency pattern.
+type currencyPattern struct {
+	prefix [2]string // positive and negative
+	suffix [2]string
+}
+
+// pattern returns the CLDR pattern of locale, falling back to the
+// customary locale of currency c, and the locale the pattern belongs to.
+// Region specific patterns are preferred over language patterns.
+func (c Currency) pattern(locale string) (currencyPattern, string, bool) {
+	for _, loc := range []string{locale, currency_locales[string(c)]} {
+		if loc == "" {
+			continue
+		}
+		lang, region := loc, ""
+		if i := strings.IndexAny(loc, "-_"); i >= 0 {
+			lang, region = loc[:i], loc[i+1:]
+		}
+		lang = strings.ToLower(lang)
+		for _, l := range []string{lang + "-" + strings.ToUpper(region), lang} {
+			if p, ok := currency_locale_patterns[l]; ok {
+				return parseCurrencyPattern(p), l, true
+			}
+		}
+	}
+	return currencyPattern{}, "", false
+}
+
+// localSymbol returns the symbol of currency c in locale.
+func (c Currency) localSymbol(locale, symbol string) string {
+	lang := locale
+	if i := strings.IndexByte(locale, '-'); i >= 0 {
+		lang = locale[:i]
+	}
+	for _, l := range []string{locale, lang, ""} {
+		if s, ok := currency_locale_symbols[string(c)+":"+l]; ok {
+			return s
+		}
+	}
+	return symbol
+}
+
+func parseCurrencyPattern(s string) currencyPattern {
+	var p currencyPattern
+	parts := strings.SplitN(s, ";", 2)
+	for i, part := range parts {
+		first, last := strings.IndexAny(part, "#0"), strings.LastIndexAny(part, "#0")
+		p.prefix[i], p.suffix[i] = part[:first], part[last+1:]
+	}
+	if len(parts) == 1 {
+		p.prefix[1], p.suffix[1] = "-"+p.prefix[0], p.suffix[0]
+	}
+	return p
+}
+
+func (p currencyPattern) symbolFirst() bool {
+	return strings.Contains(p.prefix[0], "¤")
+}
+
+func (p currencyPattern) symbolSpace() bool {
+	return strings.Contains(p.prefix[0]+p.suffix[0], " ")
+}
+
+func (p currencyPattern) signPosition() SignPosition {
+	prefix, suffix := p.prefix[1], p.suffix[1]
+	switch {
+	case strings.Contains(prefix, "("):
+		return SignParentheses
+	case strings.Contains(suffix, "-"):
+		return SignTrailing
+	case strings.Index(prefix, "¤") >= 0 && strings.Index(prefix, "¤") < strings.Index(prefix, "-"):
+		return SignAfterSymbol
+	}
+	return SignBeforeSymbol
+}
+
+// render writes number with the affixes of the pattern. A + sign uses the
+// negative affixes with the minus replaced, an empty symbol drops the
+// symbol and the space next to it.
+func (p currencyPattern) render(number, sign, symbol string) string {
+	i := 0
+	if sign != "" {
+		i = 1
+	}
+	prefix, suffix := p.prefix[i], p.suffix[i]
+	if sign == "+" {
+		prefix = strings.Replace(prefix, "-", "+", 1)
+		suffix = strings.Replace(suffix, "-", "+", 1)
+	}
+	if symbol == "" {
+		prefix = strings.TrimSpace(strings.Replace(prefix, "¤", "", 1))
+		suffix = strings.TrimSpace(strings.Replace(suffix, "¤", "", 1))
+	} else {
+		// CLDR currency spacing separates letters of the symbol from digits
+		if r, _ := utf8.DecodeLastRuneInString(symbol); strings.HasSuffix(prefix, "¤") && unicode.IsLetter(r) {
+			prefix += " "
+		}
+		if r, _ := utf8.DecodeRuneInString(symbol); strings.HasPrefix(suffix, "¤") && unicode.IsLetter(r) {
+			suffix = " " + suffix
+		}
+		prefix = strings.Replace(prefix, "¤", symbol, 1)
+		suffix = strings.Replace(suffix, "¤", symbol, 1)
+	}
+	return prefix + number + suffix
+}
+
+// Short scale magnitude suffixes used by CurrencyStyleCompact.
+var compactSuffixes = []string{"", "K", "M", "B", "T"}
+
+// formatCompact writes val with one decimal below 10 and no decimals
+// above, e.g. 1.2M or 12K. Amounts below 1000 use the standard style.
+func (c currency) formatCompact(code Currency, val float64, opts *CurrencyOptions) string {
+	abs, i := math.Abs(val), 0
+	for abs >= 1000 && i < len(compactSuffixes)-1 {
+		abs /= 1000
+		i++
+	}
+	digits := 0
+	if abs < 10 {
+		digits = 1
+	}
+	// round halves away from zero like Currency.Round
+	scale := math.Pow10(digits)
+	number := strconv.FormatFloat(math.Round(abs*scale)/scale, 'f', digits, 64)
+	// rounding may carry into the next magnitude, e.g. 999.7K
+	if number == "1000" && i < len(compactSuffixes)-1 {
+		number, i = "1", i+1
+	}
+	number = strings.TrimSuffix(number, ".0")
+	number = strings.Replace(number, ".", c.DecimalMark, 1)
+
+	return c.decorate(code, number+compactSuffixes[i], val, opts)
+}
+
+// formatSpelled writes val in English words the way amounts are written
+// on cheques, e.g. "One thousand two hundred thirty-four and 56/100 Euro".
+// The minor unit is written as a fraction unless cents are disabled. The
+// amount is rounded to the precision selected in opts, exact to any number
+// of decimals. Amounts too large to spell use the standard style.
+func (c currency) formatSpelled(code Currency, val float64, opts *CurrencyOptions) string {
+	digits, increment := code.Rounding(opts.Precision)
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+	units, ok := roundMinorUnits(val, pow, int64(increment))
+	if !ok {
+		o := *opts
+		o.FormatStyle = CurrencyStyleStandard
+		return code.Format(val, &o)
+	}
+	integer, fraction := new(big.Int).QuoRem(units, pow, new(big.Int))
+	words, ok := spellNumber(integer)
+	if !ok {
+		o := *opts
+		o.FormatStyle = CurrencyStyleStandard
+		return code.Format(val, &o)
+	}
+	if math.Signbit(val) && units.Sign() > 0 {
+		words = "minus " + words
+	}
+	if opts.WithCents && digits > 0 {
+		f := fraction.String()
+		f = strings.Repeat("0", digits-len(f)) + f
+		words = fmt.Sprintf("%s and %s/%s", words, f, pow)
+	}
+	return strings.ToUpper(words[:1]) + words[1:] + " " + c.Name
+}
+
+// roundMinorUnits returns the absolute value of val in minor units of
+// size 1/pow, rounded half away from zero to a multiple of increment. It
+// uses the shortest decimal form of val, so 0.125 rounds to 13 cents.
+func roundMinorUnits(val float64, pow *big.Int, increment int64) (*big.Int, bool) {
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		return nil, false
+	}
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(math.Abs(val), 'g', -1, 64))
+	if !ok {
+		return nil, false
+	}
+	r.Mul(r, new(big.Rat).SetFrac(pow, big.NewInt(increment)))
+	n, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Lsh(rem, 1).Cmp(r.Denom()) >= 0 {
+		n.Add(n, big.NewInt(1))
+	}
+	return n.Mul(n, big.NewInt(increment)), true
+}
+
+var (
+	spelledOnes = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+		"seventeen", "eighteen", "nineteen",
+	}
+	spelledTens = []string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+	}
+	spelledScales = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+		"sextillion", "septillion", "octillion", "nonillion", "decillion",
+	}
+)
+
+// spellNumber returns n in English words using the short scale, e.g.
+// "one thousand two hundred thirty-four". Numbers of a decillion times a
+// thousand and above cannot be spelled.
+func spellNumber(n *big.Int) (string, bool) {
+	if n.Sign() == 0 {
+		return spelledOnes[0], true
+	}
+	var (
+		groups   = make([]string, 0)
+		thousand = big.NewInt(1000)
+		v        = new(big.Int).Set(n)
+		g        = new(big.Int)
+	)
+	for i := 0; v.Sign() > 0; i++ {
+		if i == len(spelledScales) {
+			return "", false
+		}
+		v.QuoRem(v, thousand, g)
+		if g.Sign() > 0 {
+			w := spellHundreds(int(g.Int64()))
+			if spelledScales[i] != "" {
+				w += " " + spelledScales[i]
+			}
+			groups = append([]string{w}, groups...)
+		}
+	}
+	return strings.Join(groups, " "), true
+}
+
+func spellHundreds(n int) string {
+	words := make([]string, 0, 3)
+	if n >= 100 {
+		words = append(words, spelledOnes[n/100], "hundred")
+		n %= 100
+	}
+	switch {
+	case n >= 20 && n%10 > 0:
+		words = append(words, spelledTens[n/10]+"-"+spelledOnes[n%10])
+	case n >= 20:
+		words = append(words, spelledTens[n/10])
+	case n > 0:
+		words = append(words, spelledOnes[n])
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"testing"
+)
+
+func TestFormatSpelled(t *testing.T) {
+	for _, def := range []CustomCurrency{
+		{Code: "TESTWEI", Name: "Test Wei", Decimals: 18},
+		{Code: "TESTD25", Name: "Test Token", Decimals: 25},
+	} {
+		if err := RegisterCurrency(def); err != nil {
+			t.Fatal(err)
+		}
+	}
+	spelled := func() *CurrencyOptions {
+		return NewCurrencyOptions().Style(CurrencyStyleSpelled)
+	}
+	tests := []struct {
+		code string
+		val  float64
+		opts *CurrencyOptions
+		want string
+	}{
+		{"EUR", 1234.56, spelled(), "One thousand two hundred thirty-four and 56/100 Euro"},
+		{"EUR", -0.5, spelled(), "Minus zero and 50/100 Euro"},
+		{"EUR", 0.125, spelled(), "Zero and 13/100 Euro"},
+		{"EUR", 12, spelled().Cents(false), "Twelve Euro"},
+		{"JPY", 2.5, spelled(), "Three Japanese Yen"},
+		{"CHF", 12.53, spelled().Cash(true), "Twelve and 55/100 Swiss Franc"},
+		{"TESTWEI", 12.5, spelled(), "Twelve and 500000000000000000/1000000000000000000 Test Wei"},
+		{"TESTWEI", 1e21, spelled().Cents(false), "One sextillion Test Wei"},
+		{"TESTD25", 0.25, spelled(), "Zero and 2500000000000000000000000/10000000000000000000000000 Test Token"},
+	}
+	for _, tt := range tests {
+		if got := Currency(tt.code).Format(tt.val, tt.opts); got != tt.want {
+			t.Errorf("%s: Format(%v) = %q, want %q", tt.code, tt.val, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// CustomCurrency defines a currency outside ISO 4217 like a crypto-asset,
+// a stablecoin or loyalty points.
+type CustomCurrency struct {
+	Code        string // 2 to 12 letters and digits, e.g. ETH or USDT
+	Name        string
+	Symbol      string
+	SymbolFirst bool
+	SubUnit     string // name of the minor unit, e.g. Wei
+	Decimals    int    // digits of the minor unit, may exceed 18
+}
+
+// RegisterCurrency adds or replaces custom currency def in the default
+// registry. Custom codes are accepted by ParseCurrency and in text and SQL
+// conversion like ISO codes, use IsCustom to tell them apart.
+func RegisterCurrency(def CustomCurrency) error {
+	return updateDefault(func(b *Builder) error {
+		return b.SetCustomCurrency(def)
+	})
+}
+
+// isISOCurrency returns true when code is a current or withdrawn ISO 4217
+// currency of the default registry.
+func isISOCurrency(code string) bool {
+	r := Default()
+	if cc, ok := r.currencies[code]; ok {
+		return !cc.Custom
+	}
+	return contains(r.historicCodes, code)
+}
+
+// IsCustom returns true when c is not an ISO 4217 currency code.
+func (c Currency) IsCustom() bool {
+	return c.info().Custom
+}
+
+// Decimals returns the number of digits of the minor unit of currency c.
+func (c Currency) Decimals() int {
+	return c.info().SubUnitPrecision
+}
+
+// SubUnitToUnit returns the number of minor units per unit of currency
+// c. Unlike the formatting data it is not limited to 18 decimals.
+func (c Currency) SubUnitToUnit() *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(c.Decimals())), nil)
+}
+
+// ParseMinorUnits parses a decimal amount like "-1.5" and returns it in
+// minor units of currency c without loss of precision. Amounts with more
+// fractional digits than the currency's minor unit are rejected.
+func (c Currency) ParseMinorUnits(s string) (*big.Int, error) {
+	v := strings.TrimSpace(s)
+	neg := strings.HasPrefix(v, "-")
+	v = strings.TrimPrefix(strings.TrimPrefix(v, "-"), "+")
+	integer, fraction := v, ""
+	if i := strings.IndexByte(v, '.'); i >= 0 {
+		integer, fraction = v[:i], v[i+1:]
+	}
+	fraction = strings.TrimRight(fraction, "0")
+	if integer == "" && fraction == "" || len(fraction) > c.Decimals() {
+		return nil, fmt.Errorf("iso: invalid %s amount '%s'", string(c), s)
+	}
+	digits := integer + fraction + strings.Repeat("0", c.Decimals()-len(fraction))
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return nil, fmt.Errorf("iso: invalid %s amount '%s'", string(c), s)
+		}
+	}
+	n, _ := new(big.Int).SetString(digits, 10)
+	if neg {
+		n.Neg(n)
+	}
+	return n, nil
+}
+
+// FormatMinorUnits returns n minor units of currency c as a plain decimal
+// string with all digits of the minor unit, e.g. 0.000000000000000001.
+func (c Currency) FormatMinorUnits(n *big.Int) string {
+	d := c.Decimals()
+	s := new(big.Int).Abs(n).String()
+	if len(s) <= d {
+		s = strings.Repeat("0", d-len(s)+1) + s
+	}
+	if d > 0 {
+		s = s[:len(s)-d] + "." + s[len(s)-d:]
+	}
+	if n.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
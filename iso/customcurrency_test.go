@@ -0,0 +1,140 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"testing"
+)
+
+func TestFormatCustomCurrencyWithoutSubUnit(t *testing.T) {
+	old := Default()
+	defer SetDefault(old)
+
+	err := RegisterCurrency(CustomCurrency{
+		Code:        "TESTETH",
+		Name:        "Test Ether",
+		Symbol:      "Ξ",
+		SymbolFirst: true,
+		Decimals:    18,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := ParseCurrency("TESTETH")
+	if !c.IsCustom() {
+		t.Fatalf("%s: not a custom currency", c)
+	}
+	if got, want := c.Format(1.5, nil), "Ξ1.500000000000000000"; got != want {
+		t.Errorf("Format(1.5) = %q, want %q", got, want)
+	}
+}
+
+func TestCustomCurrency18Decimals(t *testing.T) {
+	old := Default()
+	defer SetDefault(old)
+
+	err := RegisterCurrency(CustomCurrency{
+		Code:        "TESTWEI",
+		Name:        "Test Ether",
+		Symbol:      "Ξ",
+		SymbolFirst: true,
+		SubUnit:     "Wei",
+		Decimals:    18,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := ParseCurrency("TESTWEI")
+
+	money := []struct {
+		in   string
+		want string
+	}{
+		{"1.000000000000000001", "Ξ1.000000000000000001"},
+		{"0.000000000000000001", "Ξ0.000000000000000001"},
+		{"-0.000000000000000001", "-Ξ0.000000000000000001"},
+		{"123456789.123456789123456789", "Ξ123,456,789.123456789123456789"},
+		{"0", "Ξ0.000000000000000000"},
+	}
+	for _, tt := range money {
+		n, err := c.ParseMinorUnits(tt.in)
+		if err != nil {
+			t.Errorf("ParseMinorUnits(%q): %v", tt.in, err)
+			continue
+		}
+		m := NewMoneyBig(n, c)
+		if got := m.Format(nil); got != tt.want {
+			t.Errorf("Money(%s).Format = %q, want %q", tt.in, got, tt.want)
+		}
+		if got := m.Amount(); got != c.FormatMinorUnits(n) {
+			t.Errorf("Money(%s).Amount = %q", tt.in, got)
+		}
+	}
+
+	floats := []struct {
+		val  float64
+		want string
+	}{
+		{1.1, "Ξ1.100000000000000000"},
+		{0.3, "Ξ0.300000000000000000"},
+		{-2.05, "-Ξ2.050000000000000000"},
+		{1e-18, "Ξ0.000000000000000001"},
+	}
+	for _, tt := range floats {
+		if got := c.Format(tt.val, nil); got != tt.want {
+			t.Errorf("Format(%v) = %q, want %q", tt.val, got, tt.want)
+		}
+	}
+
+	units := []struct {
+		val  float64
+		want int64
+		ok   bool
+	}{
+		{1.1, 1100000000000000000, true},
+		{-0.3, -300000000000000000, true},
+		{1e-18, 1, true},
+		{9.2, 9200000000000000000, true},
+		{9.3, 0, false},
+	}
+	for _, tt := range units {
+		got, err := c.MinorUnits(tt.val)
+		if (err == nil) != tt.ok || got != tt.want {
+			t.Errorf("MinorUnits(%v) = %d, %v, want %d, ok=%t", tt.val, got, err, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestMoneyFormatMatchesCurrencyFormat(t *testing.T) {
+	opts := []*CurrencyOptions{
+		nil,
+		NewCurrencyOptions().Cash(true),
+		NewCurrencyOptions().Style(CurrencyStyleAccounting),
+		NewCurrencyOptions().Currency(true).Symbol(false).Separator(false),
+	}
+	for _, code := range []Currency{"USD", "JPY", "KWD", "CHF", "SEK"} {
+		for _, n := range []int64{0, 1, -1, 1253, -1253, 123456789} {
+			m := NewMoney(n, code)
+			for _, o := range opts {
+				if got, want := m.Format(o), code.Format(m.Float64(), o); got != want {
+					t.Errorf("%s: Format = %q, want %q", m, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestRegisterCurrencyRejectsISOCodes(t *testing.T) {
+	old := Default()
+	defer SetDefault(old)
+
+	for _, code := range []string{"USD", "eur", "DEM"} {
+		if err := RegisterCurrency(CustomCurrency{Code: code, Decimals: 2}); err == nil {
+			t.Errorf("RegisterCurrency(%s) succeeded, want error", code)
+		}
+	}
+	if err := RegisterCurrency(CustomCurrency{Code: "PTS", Decimals: -1}); err == nil {
+		t.Errorf("RegisterCurrency with negative decimals succeeded, want error")
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+// Dependency describes the relation of a territory with its own ISO 3166-1
+// code to the sovereign state it belongs to.
+type Dependency struct {
+	Sovereign Country // undefined for territories without a sovereign
+	Customs   bool    // part of the sovereign's customs territory
+	VAT       bool    // part of the sovereign's VAT area
+}
+
+// Dependent territories, overseas regions and areas in free association.
+// Countries not listed here are sovereign states. Note that territories
+// inside a customs or VAT area of an EU member are part of the EU customs
+// union or VAT area as well.
+var country_dependencies = map[string]Dependency{
+	"AI": {"GB", false, false},
+	"AQ": {CountryUndefined, false, false},
+	"AS": {"US", false, false},
+	"AW": {"NL", false, false},
+	"AX": {"FI", true, false},
+	"BL": {"FR", false, false},
+	"BM": {"GB", false, false},
+	"BQ": {"NL", false, false},
+	"BV": {"NO", false, false},
+	"CC": {"AU", true, false},
+	"CK": {"NZ", false, false},
+	"CW": {"NL", false, false},
+	"CX": {"AU", true, false},
+	"FK": {"GB", false, false},
+	"FO": {"DK", false, false},
+	"GF": {"FR", true, false},
+	"GG": {"GB", true, false},
+	"GI": {"GB", false, false},
+	"GL": {"DK", false, false},
+	"GP": {"FR", true, false},
+	"GS": {"GB", false, false},
+	"GU": {"US", false, false},
+	"HK": {"CN", false, false},
+	"HM": {"AU", false, false},
+	"IM": {"GB", true, true},
+	"IO": {"GB", false, false},
+	"JE": {"GB", true, false},
+	"KY": {"GB", false, false},
+	"MF": {"FR", true, false},
+	"MO": {"CN", false, false},
+	"MP": {"US", false, false},
+	"MQ": {"FR", true, false},
+	"MS": {"GB", false, false},
+	"NC": {"FR", false, false},
+	"NF": {"AU", true, false},
+	"NU": {"NZ", false, false},
+	"PF": {"FR", false, false},
+	"PM": {"FR", false, false},
+	"PN": {"GB", false, false},
+	"PR": {"US", true, false},
+	"RE": {"FR", true, false},
+	"SH": {"GB", false, false},
+	"SJ": {"NO", false, false},
+	"SX": {"NL", false, false},
+	"TC": {"GB", false, false},
+	"TF": {"FR", false, false},
+	"TK": {"NZ", false, false},
+	"UM": {"US", false, false},
+	"VG": {"GB", false, false},
+	"VI": {"US", false, false},
+	"WF": {"FR", false, false},
+	"YT": {"FR", true, false},
+}
+
+// IsSovereign returns true when c is a sovereign state.
+func (c Country) IsSovereign() bool {
+	_, ok := Default().CountryDependency(c)
+	return c.IsValid() && !ok
+}
+
+// Sovereign returns the sovereign state territory c belongs to. Sovereign
+// states return themselves, territories without a sovereign like Antarctica
+// return CountryUndefined.
+func (c Country) Sovereign() Country {
+	if d, ok := Default().CountryDependency(c); ok {
+		return d.Sovereign
+	}
+	return c
+}
+
+// Dependencies returns the territories belonging to sovereign state c.
+func (c Country) Dependencies() CountrySet {
+	return Default().CountryDependencies(c)
+}
+
+// InCustomsTerritory returns true when c is part of its sovereign's
+// customs territory. Sovereign states are always part of their own.
+func (c Country) InCustomsTerritory() bool {
+	if d, ok := Default().CountryDependency(c); ok {
+		return d.Sovereign.IsValid() && d.Customs
+	}
+	return c.IsValid()
+}
+
+// InVATArea returns true when c is part of its sovereign's VAT area.
+// Sovereign states are always part of their own.
+func (c Country) InVATArea() bool {
+	if d, ok := Default().CountryDependency(c); ok {
+		return d.Sovereign.IsValid() && d.VAT
+	}
+	return c.IsValid()
+}
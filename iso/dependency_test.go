@@ -0,0 +1,101 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"testing"
+)
+
+func TestCountryDependency(t *testing.T) {
+	tests := []struct {
+		c         Country
+		sovereign Country
+		isSov     bool
+		customs   bool
+		vat       bool
+	}{
+		{"FR", "FR", true, true, true},
+		{"GF", "FR", false, true, false},
+		{"RE", "FR", false, true, false},
+		{"NC", "FR", false, false, false},
+		{"AX", "FI", false, true, false},
+		{"IM", "GB", false, true, true},
+		{"JE", "GB", false, true, false},
+		{"GI", "GB", false, false, false},
+		{"PR", "US", false, true, false},
+		{"GU", "US", false, false, false},
+		{"HK", "CN", false, false, false},
+		{"AQ", CountryUndefined, false, false, false},
+		{CountryUndefined, CountryUndefined, false, false, false},
+	}
+	for _, tt := range tests {
+		if got := tt.c.Sovereign(); got != tt.sovereign {
+			t.Errorf("%s: Sovereign() = %q, want %q", string(tt.c), string(got), string(tt.sovereign))
+		}
+		if got := tt.c.IsSovereign(); got != tt.isSov {
+			t.Errorf("%s: IsSovereign() = %t, want %t", string(tt.c), got, tt.isSov)
+		}
+		if got := tt.c.InCustomsTerritory(); got != tt.customs {
+			t.Errorf("%s: InCustomsTerritory() = %t, want %t", string(tt.c), got, tt.customs)
+		}
+		if got := tt.c.InVATArea(); got != tt.vat {
+			t.Errorf("%s: InVATArea() = %t, want %t", string(tt.c), got, tt.vat)
+		}
+	}
+}
+
+func TestCountryDependencies(t *testing.T) {
+	tests := []struct {
+		c    Country
+		want string
+	}{
+		{"FI", "AX"},
+		{"DK", "FO,GL"},
+		{"NZ", "CK,NU,TK"},
+		{"DE", ""},
+		{"GF", ""},
+		{CountryUndefined, ""},
+	}
+	for _, tt := range tests {
+		if got := tt.c.Dependencies().String(); got != tt.want {
+			t.Errorf("%s: Dependencies() = %q, want %q", string(tt.c), got, tt.want)
+		}
+	}
+}
+
+func TestSetCountryDependency(t *testing.T) {
+	old := Default()
+	r, err := old.Extend(func(b *Builder) error {
+		b.RemoveCountryDependency("GL")
+		return b.SetCountryDependency("EA", Dependency{"ES", true, false})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, ok := r.CountryDependency("EA"); !ok || d.Sovereign != "ES" || !d.Customs || d.VAT {
+		t.Errorf("EA: CountryDependency() = %v, %t", d, ok)
+	}
+	if _, ok := r.CountryDependency("GL"); ok {
+		t.Errorf("GL: still a dependency after RemoveCountryDependency")
+	}
+	if _, ok := old.CountryDependency("GL"); !ok {
+		t.Errorf("Extend modified the original registry")
+	}
+
+	tests := []struct {
+		c Country
+		d Dependency
+	}{
+		{"FR", Dependency{"FR", true, true}}, // itself
+		{"XA", Dependency{"GF", true, true}}, // sovereign is a dependency
+	}
+	for _, tt := range tests {
+		_, err := old.Extend(func(b *Builder) error {
+			return b.SetCountryDependency(tt.c, tt.d)
+		})
+		if err == nil {
+			t.Errorf("SetCountryDependency(%s, %v): expected error", string(tt.c), tt.d)
+		}
+	}
+}
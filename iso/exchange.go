@@ -0,0 +1,296 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ExchangeRate is the price of one unit of currency From in units of
+// currency To, valid from Time until a newer rate is published.
+type ExchangeRate struct {
+	From Currency
+	To   Currency
+	Rate float64
+	Time time.Time
+}
+
+// Invert returns the rate for the opposite direction.
+func (r ExchangeRate) Invert() ExchangeRate {
+	return ExchangeRate{From: r.To, To: r.From, Rate: 1 / r.Rate, Time: r.Time}
+}
+
+// Convert converts amount from currency From to currency To and rounds
+// the result to the minor unit of To.
+func (r ExchangeRate) Convert(amount float64) float64 {
+	return r.To.Round(amount * r.Rate)
+}
+
+// RateSource provides exchange rates. Rate returns the most recent rate
+// published at or before at, or the latest rate when at is zero.
+type RateSource interface {
+	Rate(from, to Currency, at time.Time) (ExchangeRate, error)
+}
+
+var rateSource atomic.Value // rateSourceHolder
+
+type rateSourceHolder struct {
+	src RateSource
+}
+
+// SetRateSource installs src as the rate source used by Convert.
+func SetRateSource(src RateSource) {
+	rateSource.Store(rateSourceHolder{src})
+}
+
+// Convert converts amount from currency from to currency to at the rate
+// valid at time at, using the rate source installed with SetRateSource.
+// The result is rounded to the minor unit of the target currency.
+func Convert(amount float64, from, to Currency, at time.Time) (float64, error) {
+	h, _ := rateSource.Load().(rateSourceHolder)
+	if h.src == nil {
+		return 0, fmt.Errorf("iso: no exchange rate source")
+	}
+	r, err := h.src.Rate(from, to, at)
+	if err != nil {
+		return 0, err
+	}
+	return r.Convert(amount), nil
+}
+
+// MemoryRates is an in-memory rate source. Rates missing for a currency
+// pair are derived from the inverse rate or by triangulation via the base
+// currency. MemoryRates is safe for concurrent use.
+type MemoryRates struct {
+	mu     sync.RWMutex
+	base   Currency
+	maxAge time.Duration
+	rates  map[[2]Currency][]ExchangeRate // sorted by time
+}
+
+// NewMemoryRates returns an empty rate source that triangulates via
+// currency base. Base may be undefined when only direct and inverse rates
+// should be used.
+func NewMemoryRates(base Currency) *MemoryRates {
+	return &MemoryRates{
+		base:  base,
+		rates: make(map[[2]Currency][]ExchangeRate),
+	}
+}
+
+// Base returns the currency used for triangulation.
+func (m *MemoryRates) Base() Currency {
+	return m.base
+}
+
+// SetMaxAge limits how old a rate may be at the requested time, or at the
+// current time when no time is requested. Rate fails when the newest rate
+// of a pair is older. Zero disables the limit, which is the default.
+func (m *MemoryRates) SetMaxAge(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.maxAge = d
+}
+
+// Add adds rates or replaces rates with the same currency pair and time.
+func (m *MemoryRates) Add(rates ...ExchangeRate) error {
+	for _, r := range rates {
+		if !r.From.IsValid() || !r.To.IsValid() || r.From == r.To {
+			return fmt.Errorf("iso: invalid exchange rate pair '%s/%s'", string(r.From), string(r.To))
+		}
+		if !(r.Rate > 0) {
+			return fmt.Errorf("iso: invalid %s/%s exchange rate %v", string(r.From), string(r.To), r.Rate)
+		}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, r := range rates {
+		key := [2]Currency{r.From, r.To}
+		list := m.rates[key]
+		i := sort.Search(len(list), func(i int) bool { return !list[i].Time.Before(r.Time) })
+		if i < len(list) && list[i].Time.Equal(r.Time) {
+			list[i] = r
+			continue
+		}
+		list = append(list, ExchangeRate{})
+		copy(list[i+1:], list[i:])
+		list[i] = r
+		m.rates[key] = list
+	}
+	return nil
+}
+
+// Rate implements RateSource. Triangulated rates carry the time of the
+// older of the two rates used.
+func (m *MemoryRates) Rate(from, to Currency, at time.Time) (ExchangeRate, error) {
+	if from == to {
+		return ExchangeRate{From: from, To: to, Rate: 1, Time: at}, nil
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	r, err := m.rate(from, to, at)
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+	if m.maxAge > 0 {
+		now := at
+		if now.IsZero() {
+			now = time.Now()
+		}
+		if age := now.Sub(r.Time); age > m.maxAge {
+			return ExchangeRate{}, fmt.Errorf("iso: %s/%s exchange rate of %s is older than %s", string(from), string(to), r.Time.Format(time.RFC3339), m.maxAge)
+		}
+	}
+	return r, nil
+}
+
+func (m *MemoryRates) rate(from, to Currency, at time.Time) (ExchangeRate, error) {
+	if r, ok := m.lookup(from, to, at); ok {
+		return r, nil
+	}
+	if m.base.IsValid() && from != m.base && to != m.base {
+		r1, ok1 := m.lookup(from, m.base, at)
+		r2, ok2 := m.lookup(m.base, to, at)
+		if ok1 && ok2 {
+			r := ExchangeRate{From: from, To: to, Rate: r1.Rate * r2.Rate, Time: r1.Time}
+			if r2.Time.Before(r.Time) {
+				r.Time = r2.Time
+			}
+			return r, nil
+		}
+	}
+	if at.IsZero() {
+		return ExchangeRate{}, fmt.Errorf("iso: no %s/%s exchange rate", string(from), string(to))
+	}
+	return ExchangeRate{}, fmt.Errorf("iso: no %s/%s exchange rate at %s", string(from), string(to), at.Format(time.RFC3339))
+}
+
+// lookup returns the direct or inverse rate for a currency pair.
+func (m *MemoryRates) lookup(from, to Currency, at time.Time) (ExchangeRate, bool) {
+	if r, ok := m.find([2]Currency{from, to}, at); ok {
+		return r, true
+	}
+	if r, ok := m.find([2]Currency{to, from}, at); ok {
+		return r.Invert(), true
+	}
+	return ExchangeRate{}, false
+}
+
+func (m *MemoryRates) find(key [2]Currency, at time.Time) (ExchangeRate, bool) {
+	list := m.rates[key]
+	if len(list) == 0 {
+		return ExchangeRate{}, false
+	}
+	if at.IsZero() {
+		return list[len(list)-1], true
+	}
+	i := sort.Search(len(list), func(i int) bool { return list[i].Time.After(at) })
+	if i == 0 {
+		return ExchangeRate{}, false
+	}
+	return list[i-1], true
+}
+
+type ecbEnvelope struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// LoadECBRates reads euro foreign exchange reference rates in the XML
+// format published by the European Central Bank, e.g. eurofxref-daily.xml
+// or eurofxref-hist.xml, and returns them as a rate source with base EUR.
+// Rates are valid from midnight UTC of their publication date. Currencies
+// unknown to the default registry are skipped.
+// https://www.ecb.europa.eu/stats/policy_and_exchange_rates/euro_reference_exchange_rates/html/index.en.html
+func LoadECBRates(r io.Reader) (*MemoryRates, error) {
+	var env ecbEnvelope
+	if err := xml.NewDecoder(r).Decode(&env); err != nil {
+		return nil, fmt.Errorf("iso: reading ECB rates: %v", err)
+	}
+	eur := Currency("EUR")
+	m := NewMemoryRates(eur)
+	for _, day := range env.Days {
+		t, err := time.Parse("2006-01-02", day.Time)
+		if err != nil {
+			return nil, fmt.Errorf("iso: invalid ECB rate date '%s'", day.Time)
+		}
+		list := make([]ExchangeRate, 0, len(day.Rates))
+		for _, v := range day.Rates {
+			c := ParseCurrency(v.Currency)
+			if !c.IsValid() {
+				continue
+			}
+			rate, err := strconv.ParseFloat(v.Rate, 64)
+			if err != nil {
+				return nil, fmt.Errorf("iso: invalid ECB %s rate '%s'", v.Currency, v.Rate)
+			}
+			list = append(list, ExchangeRate{From: eur, To: c, Rate: rate, Time: t})
+		}
+		if err := m.Add(list...); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// ECBFile is a rate source backed by an ECB reference rate file on disk.
+// The file is read on first use and read again whenever its modification
+// time changes, so it can be replaced by a periodic download.
+type ECBFile struct {
+	name  string
+	mu    sync.Mutex
+	mod   time.Time
+	rates *MemoryRates
+}
+
+// NewECBFile returns a rate source reading ECB reference rates from the
+// file name.
+func NewECBFile(name string) *ECBFile {
+	return &ECBFile{name: name}
+}
+
+// Rate implements RateSource.
+func (f *ECBFile) Rate(from, to Currency, at time.Time) (ExchangeRate, error) {
+	m, err := f.load()
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+	return m.Rate(from, to, at)
+}
+
+func (f *ECBFile) load() (*MemoryRates, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fi, err := os.Stat(f.name)
+	if err != nil {
+		return nil, err
+	}
+	if f.rates != nil && fi.ModTime().Equal(f.mod) {
+		return f.rates, nil
+	}
+	file, err := os.Open(f.name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	m, err := LoadECBRates(file)
+	if err != nil {
+		return nil, err
+	}
+	f.rates, f.mod = m, fi.ModTime()
+	return m, nil
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+const ecbTestRates = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time="2020-03-03">
+			<Cube currency="USD" rate="1.1139"/>
+			<Cube currency="JPY" rate="119.94"/>
+			<Cube currency="GBP" rate="0.86945"/>
+			<Cube currency="XYZ" rate="1.5"/>
+		</Cube>
+		<Cube time="2020-03-02">
+			<Cube currency="USD" rate="1.1117"/>
+			<Cube currency="JPY" rate="119.41"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func TestLoadECBRates(t *testing.T) {
+	m, err := LoadECBRates(strings.NewReader(ecbTestRates))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Base() != "EUR" {
+		t.Errorf("Base = %s, want EUR", m.Base())
+	}
+	day := time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		from, to Currency
+		at       time.Time
+		rate     float64
+		time     time.Time
+	}{
+		{"EUR", "USD", day, 1.1117, day},
+		{"EUR", "USD", day.Add(23 * time.Hour), 1.1117, day},
+		{"EUR", "USD", day.AddDate(0, 0, 1), 1.1139, day.AddDate(0, 0, 1)},
+		{"EUR", "USD", time.Time{}, 1.1139, day.AddDate(0, 0, 1)},
+		{"EUR", "GBP", day.AddDate(0, 0, 5), 0.86945, day.AddDate(0, 0, 1)},
+		{"JPY", "EUR", day, 1 / 119.41, day},
+	}
+	for _, tt := range tests {
+		r, err := m.Rate(tt.from, tt.to, tt.at)
+		if err != nil {
+			t.Errorf("Rate(%s, %s): %v", tt.from, tt.to, err)
+			continue
+		}
+		if math.Abs(r.Rate-tt.rate) > 1e-12 || !r.Time.Equal(tt.time) {
+			t.Errorf("Rate(%s, %s) = %v at %s, want %v at %s", tt.from, tt.to, r.Rate, r.Time, tt.rate, tt.time)
+		}
+	}
+	// GBP is published on the later day only
+	if _, err := m.Rate("EUR", "GBP", day); err == nil {
+		t.Errorf("Rate(EUR, GBP) before first publication: expected error")
+	}
+	// unknown currencies are skipped
+	if _, err := m.Rate("EUR", "XYZ", time.Time{}); err == nil {
+		t.Errorf("Rate(EUR, XYZ): expected error")
+	}
+}
+
+func TestLoadECBRatesInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"not xml",
+		`<Envelope><Cube><Cube time="2020-03-02"><Cube currency="USD" rate="1.1"/></Cube>`,
+		`<Envelope><Cube><Cube time="02.03.2020"><Cube currency="USD" rate="1.1"/></Cube></Cube></Envelope>`,
+		`<Envelope><Cube><Cube time="2020-03-02"><Cube currency="USD" rate="1,1"/></Cube></Cube></Envelope>`,
+		`<Envelope><Cube><Cube time="2020-03-02"><Cube currency="USD" rate="0"/></Cube></Cube></Envelope>`,
+		`<Envelope><Cube><Cube time="2020-03-02"><Cube currency="USD" rate="-1.1"/></Cube></Cube></Envelope>`,
+		`<Envelope><Cube><Cube time="2020-03-02"><Cube currency="EUR" rate="1"/></Cube></Cube></Envelope>`,
+	}
+	for _, in := range tests {
+		if _, err := LoadECBRates(strings.NewReader(in)); err == nil {
+			t.Errorf("LoadECBRates(%q): expected error", in)
+		}
+	}
+}
+
+func TestMemoryRatesTriangulation(t *testing.T) {
+	day := time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC)
+	m := NewMemoryRates("EUR")
+	err := m.Add(
+		ExchangeRate{From: "EUR", To: "USD", Rate: 1.25, Time: day},
+		ExchangeRate{From: "EUR", To: "JPY", Rate: 125, Time: day.AddDate(0, 0, -1)},
+		ExchangeRate{From: "GBP", To: "EUR", Rate: 1.2, Time: day},
+		ExchangeRate{From: "USD", To: "CHF", Rate: 0.95, Time: day},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		from, to Currency
+		rate     float64
+		time     time.Time
+	}{
+		{"EUR", "USD", 1.25, day},                  // direct
+		{"USD", "EUR", 0.8, day},                   // inverse
+		{"USD", "JPY", 100, day.AddDate(0, 0, -1)}, // via EUR, older rate time
+		{"JPY", "USD", 0.01, day.AddDate(0, 0, -1)},
+		{"GBP", "USD", 1.5, day},
+		{"USD", "GBP", 1 / 1.5, day},
+		{"USD", "CHF", 0.95, day}, // direct rate wins over the base
+		{"USD", "USD", 1, day},
+	}
+	for _, tt := range tests {
+		r, err := m.Rate(tt.from, tt.to, day)
+		if err != nil {
+			t.Errorf("Rate(%s, %s): %v", tt.from, tt.to, err)
+			continue
+		}
+		if r.From != tt.from || r.To != tt.to {
+			t.Errorf("Rate(%s, %s) pair = %s/%s", tt.from, tt.to, r.From, r.To)
+		}
+		if math.Abs(r.Rate-tt.rate) > 1e-12 || !r.Time.Equal(tt.time) {
+			t.Errorf("Rate(%s, %s) = %v at %s, want %v at %s", tt.from, tt.to, r.Rate, r.Time, tt.rate, tt.time)
+		}
+	}
+
+	// CHF is only quoted against USD, not against the base
+	if _, err := m.Rate("EUR", "CHF", day); err == nil {
+		t.Errorf("Rate(EUR, CHF): expected error")
+	}
+	// JPY/GBP cannot be triangulated before both rates exist
+	if _, err := m.Rate("JPY", "GBP", day.AddDate(0, 0, -1)); err == nil {
+		t.Errorf("Rate(JPY, GBP) before GBP rate: expected error")
+	}
+	// without a base only direct and inverse rates are used
+	n := NewMemoryRates(CurrencyUndefined)
+	n.Add(m.rates[[2]Currency{"EUR", "USD"}]...)
+	n.Add(m.rates[[2]Currency{"EUR", "JPY"}]...)
+	if _, err := n.Rate("USD", "JPY", day); err == nil {
+		t.Errorf("Rate(USD, JPY) without base: expected error")
+	}
+}
+
+func TestMemoryRatesAddInvalid(t *testing.T) {
+	m := NewMemoryRates("EUR")
+	tests := []ExchangeRate{
+		{From: "EUR", To: "EUR", Rate: 1},
+		{From: "EUR", To: CurrencyUndefined, Rate: 1},
+		{From: "EUR", To: "USD", Rate: 0},
+		{From: "EUR", To: "USD", Rate: -1},
+		{From: "EUR", To: "USD", Rate: math.NaN()},
+	}
+	for _, r := range tests {
+		if err := m.Add(r); err == nil {
+			t.Errorf("Add(%s/%s %v): expected error", r.From, r.To, r.Rate)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	h, _ := rateSource.Load().(rateSourceHolder)
+	defer SetRateSource(h.src)
+
+	SetRateSource(nil)
+	if _, err := Convert(1, "EUR", "USD", time.Time{}); err == nil {
+		t.Errorf("Convert without rate source: expected error")
+	}
+
+	day := time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC)
+	m := NewMemoryRates("EUR")
+	err := m.Add(
+		ExchangeRate{From: "EUR", To: "USD", Rate: 1.1, Time: day},
+		ExchangeRate{From: "EUR", To: "JPY", Rate: 120.1, Time: day},
+		ExchangeRate{From: "EUR", To: "BHD", Rate: 0.4123, Time: day},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetRateSource(m)
+
+	tests := []struct {
+		amount   float64
+		from, to Currency
+		want     float64
+	}{
+		{12.34, "EUR", "USD", 13.57},   // 13.574
+		{12.35, "EUR", "USD", 13.59},   // 13.585
+		{-12.35, "EUR", "USD", -13.59}, // halves away from zero
+		{1234.5, "EUR", "JPY", 148263}, // no minor unit
+		{1.23456, "EUR", "BHD", 0.509}, // three decimals
+		{10000, "JPY", "EUR", 83.26},   // inverse
+		{10000, "JPY", "USD", 91.59},   // triangulated
+		{100, "USD", "USD", 100},
+	}
+	for _, tt := range tests {
+		got, err := Convert(tt.amount, tt.from, tt.to, day)
+		if err != nil {
+			t.Errorf("Convert(%v, %s, %s): %v", tt.amount, tt.from, tt.to, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Convert(%v, %s, %s) = %v, want %v", tt.amount, tt.from, tt.to, got, tt.want)
+		}
+	}
+
+	if _, err := Convert(1, "EUR", "CHF", day); err == nil {
+		t.Errorf("Convert(EUR, CHF): expected error")
+	}
+	if _, err := Convert(1, "EUR", "USD", day.AddDate(0, 0, -1)); err == nil {
+		t.Errorf("Convert before first rate: expected error")
+	}
+}
+
+func TestMemoryRatesMaxAge(t *testing.T) {
+	day := time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC)
+	m := NewMemoryRates("EUR")
+	err := m.Add(
+		ExchangeRate{From: "EUR", To: "USD", Rate: 1.1, Time: day},
+		ExchangeRate{From: "EUR", To: "CHF", Rate: 1.06, Time: day.AddDate(0, 0, -3)},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.SetMaxAge(48 * time.Hour)
+
+	tests := []struct {
+		from, to Currency
+		at       time.Time
+		ok       bool
+	}{
+		{"EUR", "USD", day.Add(12 * time.Hour), true},
+		{"USD", "EUR", day.AddDate(0, 0, 2), true},
+		{"EUR", "USD", day.AddDate(0, 0, 3), false},
+		{"EUR", "USD", time.Time{}, false}, // checked against now
+		{"EUR", "CHF", day, false},
+		{"USD", "CHF", day, false}, // triangulated via the older CHF rate
+		{"CHF", "EUR", day.AddDate(0, 0, -2), true},
+	}
+	for _, tt := range tests {
+		_, err := m.Rate(tt.from, tt.to, tt.at)
+		if (err == nil) != tt.ok {
+			t.Errorf("Rate(%s, %s, %s): error %v, want ok=%t", tt.from, tt.to, tt.at.Format(time.RFC3339), err, tt.ok)
+		}
+	}
+
+	m.SetMaxAge(0)
+	if _, err := m.Rate("EUR", "USD", time.Time{}); err != nil {
+		t.Errorf("Rate without max age: %v", err)
+	}
+}
@@ -0,0 +1,1566 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+//go:build ignore
+// +build ignore
+
+// This program generates tables.go from the upstream data snapshots in
+// the data directory. Run it with go generate. A report of added, removed
+// and changed codes is written to stdout.
+//
+// The Debian iso-codes and gountries files are verbatim copies of an
+// upstream release. The other snapshots were seeded from the formerly
+// hand-maintained tables and use the upstream file formats, their datasets
+// are marked as seeds. To refresh the tables, replace them with current
+// downloads from their upstream sources and update the release constants:
+//
+//	data/iso_3166-1.json      https://salsa.debian.org/iso-codes-team/iso-codes/-/tree/main/data
+//	data/iso_3166-2.json      https://salsa.debian.org/iso-codes-team/iso-codes/-/tree/main/data
+//	data/iso_3166-3.json      https://salsa.debian.org/iso-codes-team/iso-codes/-/tree/main/data
+//	data/cldr/*/territories.json  https://github.com/unicode-org/cldr-json (cldr-localenames-full)
+//	data/cldr/*/subdivisions.json https://github.com/unicode-org/cldr-json (cldr-localenames-full)
+//	data/cldr/supplemental/currencyData.json  https://github.com/unicode-org/cldr-json (cldr-core)
+//	data/restcountries.json   https://restcountries.com/v3.1/all
+//	data/unsd_m49.csv         https://unstats.un.org/unsd/methodology/m49/overview/
+//	data/countries.csv        https://developers.google.com/public-data/docs/canonical/countries_csv
+//	data/list_one.xml         https://www.six-group.com/en/products-services/financial-information/data-standards.html
+//	data/list_three.xml       https://www.six-group.com/en/products-services/financial-information/data-standards.html
+//	data/currency_iso.json    https://github.com/RubyMoney/money/blob/main/config/currency_iso.json
+//	data/iso_639-2.json       https://salsa.debian.org/iso-codes-team/iso-codes/-/tree/main/data
+//	data/ne_110m_admin_0_countries.geojson  https://www.naturalearthdata.com/downloads/110m-cultural-vectors/
+//	data/gountries/countries/*.yaml     https://github.com/pariz/gountries/tree/master/data/yaml/countries
+//	data/gountries/subdivisions/*.yaml  https://github.com/pariz/gountries/tree/master/data/yaml/subdivisions
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/echa/code/internal/gen"
+)
+
+func main() {
+	var b bytes.Buffer
+	b.WriteString("package iso\n\n")
+	languages := readLanguages()
+	countries, names := genCountries(&b)
+	cldr := genCountryNames(&b, languages)
+	former := genFormerCountries(&b)
+	genCountryHistory(&b, countries, names, former)
+	regions := genRegions(&b, languages)
+	genRegionAliases(&b, regions)
+	genRegionCoordinates(&b, regions)
+	genCountryInfo(&b)
+	genCountryLanguages(&b, countries, languages)
+	genCountryShapes(&b, countries)
+	historic := readHistoricCurrencies()
+	currencies, published, fractions := genCurrencies(&b, historic)
+	genCurrencyHistory(&b, currencies, historic)
+	genLanguages(&b, languages)
+	genDatasets(&b, []dataset{
+		{"ISO 3166-1", "data/iso_3166-1.json", isoCodes},
+		{"ISO 3166-2", "data/iso_3166-2.json", isoCodes},
+		{"ISO 3166-3", "data/iso_3166-3.json", isoCodes},
+		{"CLDR subdivision names", "data/cldr/*/subdivisions.json", "CLDR " + cldr},
+		{"CLDR territory names", "data/cldr/*/territories.json", "CLDR " + cldr},
+		{"Country metadata", "data/restcountries.json", seed},
+		{"UN M.49", "data/unsd_m49.csv", seed},
+		{"Country GPS", "data/countries.csv", seed},
+		{"ISO 4217", "data/list_one.xml", published},
+		{"ISO 4217 historic", "data/list_three.xml", seed},
+		{"Currency formats", "data/currency_iso.json", seed},
+		{"CLDR currency fractions", "data/cldr/supplemental/currencyData.json", "CLDR " + fractions},
+		{"ISO 639-2", "data/iso_639-2.json", isoCodes},
+		{"Country boundaries", "data/ne_110m_admin_0_countries.geojson", seed},
+		{"Small country coordinates", "data/gountries/countries/*.yaml", gountries},
+		{"Country languages", "data/gountries/countries/*.yaml", gountries},
+		{"Region coordinates", "data/gountries/subdivisions/*.yaml", gountries},
+	})
+	if err := gen.WriteGoFile("tables.go", b.Bytes(), os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func open(name string) *os.File {
+	f, err := os.Open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return f
+}
+
+func readCSV(name string, comma rune) []map[string]string {
+	f := open(name)
+	defer f.Close()
+	r := csv.NewReader(f)
+	r.Comma = comma
+	recs, err := r.ReadAll()
+	if err != nil {
+		log.Fatalf("%s: %v", name, err)
+	}
+	if len(recs) == 0 {
+		return nil
+	}
+	rows := make([]map[string]string, 0, len(recs)-1)
+	for _, rec := range recs[1:] {
+		row := make(map[string]string)
+		for i, h := range recs[0] {
+			if i < len(rec) {
+				row[strings.TrimPrefix(h, "\ufeff")] = strings.TrimSpace(rec[i])
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+type country struct {
+	Code string `json:"alpha_2"`
+	Name string `json:"name"`
+}
+
+// User-assigned codes kept in the country list.
+var userAssignedCountries = []country{
+	{"XK", "Kosovo"},
+}
+
+// genCountries writes ISO 3166-1 codes, names and coordinates and returns
+// the list of codes and their names.
+func genCountries(b *bytes.Buffer) ([]string, map[string]string) {
+	var doc map[string][]country
+	f := open("data/iso_3166-1.json")
+	if err := json.NewDecoder(f).Decode(&doc); err != nil {
+		log.Fatalf("data/iso_3166-1.json: %v", err)
+	}
+	f.Close()
+	list := append(doc["3166-1"], userAssignedCountries...)
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+
+	b.WriteString("// ISO 3166-1 Alpha-2 country codes.\n")
+	b.WriteString("// https://en.wikipedia.org/wiki/ISO_3166-1\n")
+	b.WriteString("var (\n\tISO_3166_1_COUNTRY_CODES []string = []string{\n")
+	for _, v := range list {
+		fmt.Fprintf(b, "\t\t%q,\n", v.Code)
+	}
+	b.WriteString("\t}\n)\n\n")
+
+	b.WriteString("var country_names = map[string]string{\n")
+	for _, v := range list {
+		fmt.Fprintf(b, "\t%q: %q,\n", v.Code, v.Name)
+	}
+	b.WriteString("}\n\n")
+
+	rows := readCSV("data/countries.csv", ',')
+	sort.Slice(rows, func(i, j int) bool { return rows[i]["country"] < rows[j]["country"] })
+	b.WriteString("var country_gps = map[string][2]float64{\n")
+	for _, row := range rows {
+		lat, lon := row["latitude"], row["longitude"]
+		if lat == "" || lon == "" {
+			continue
+		}
+		for _, v := range []string{lat, lon} {
+			if _, err := strconv.ParseFloat(v, 64); err != nil {
+				log.Fatalf("data/countries.csv: %s: %v", row["country"], err)
+			}
+		}
+		fmt.Fprintf(b, "\t%q: {%s, %s},\n", row["country"], lat, lon)
+	}
+	b.WriteString("}\n\n")
+
+	codes := make([]string, len(list))
+	names := make(map[string]string, len(list))
+	for i, v := range list {
+		codes[i] = v.Code
+		names[v.Code] = v.Name
+	}
+	return codes, names
+}
+
+// genCountryNames writes official country names from Debian's iso-codes
+// and CLDR territory names for all languages in data/cldr. It returns the
+// CLDR version.
+func genCountryNames(b *bytes.Buffer, languages []iso639) string {
+	var codes map[string][]struct {
+		Alpha2       string `json:"alpha_2"`
+		OfficialName string `json:"official_name"`
+	}
+	f := open("data/iso_3166-1.json")
+	if err := json.NewDecoder(f).Decode(&codes); err != nil {
+		log.Fatalf("data/iso_3166-1.json: %v", err)
+	}
+	f.Close()
+	list := codes["3166-1"]
+	sort.Slice(list, func(i, j int) bool { return list[i].Alpha2 < list[j].Alpha2 })
+	b.WriteString("var country_official_names = map[string]string{\n")
+	for _, v := range list {
+		if v.OfficialName != "" {
+			fmt.Fprintf(b, "\t%q: %q,\n", v.Alpha2, v.OfficialName)
+		}
+	}
+	b.WriteString("}\n\n")
+
+	alpha2 := make(map[string]string)
+	for _, l := range languages {
+		if l.Alpha2 != "" {
+			alpha2[l.Alpha2] = l.Code()
+		}
+	}
+	files, err := filepath.Glob("data/cldr/*/territories.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var version string
+	names := make([]string, 0)
+	for _, name := range files {
+		var doc struct {
+			Main map[string]struct {
+				Identity struct {
+					Version struct {
+						CLDR string `json:"_cldrVersion"`
+					} `json:"version"`
+				} `json:"identity"`
+				Names struct {
+					Territories map[string]string `json:"territories"`
+				} `json:"localeDisplayNames"`
+			} `json:"main"`
+		}
+		f := open(name)
+		if err := json.NewDecoder(f).Decode(&doc); err != nil {
+			log.Fatalf("%s: %v", name, err)
+		}
+		f.Close()
+		for locale, v := range doc.Main {
+			lang, ok := alpha2[locale]
+			if !ok {
+				log.Fatalf("%s: unsupported locale %q", name, locale)
+			}
+			if version != "" && version != v.Identity.Version.CLDR {
+				log.Fatalf("%s: mixed CLDR versions %s and %s", name, version, v.Identity.Version.CLDR)
+			}
+			version = v.Identity.Version.CLDR
+			for code, n := range v.Names.Territories {
+				// skip alternate names and numeric region codes
+				if len(code) == 2 && code[0] >= 'A' {
+					names = append(names, fmt.Sprintf("\t%q: %q,\n", lang+":"+code, n))
+				}
+			}
+		}
+	}
+	sort.Strings(names)
+	b.WriteString("// CLDR territory names keyed by ISO 639-2/T language and country code.\n")
+	fmt.Fprintf(b, "// http://cldr.unicode.org (CLDR %s)\n", version)
+	b.WriteString("var country_names_cldr = map[string]string{\n")
+	for _, n := range names {
+		b.WriteString(n)
+	}
+	b.WriteString("}\n\n")
+	return version
+}
+
+var continents = map[string]string{
+	"Africa":        "AF",
+	"Antarctica":    "AN",
+	"Asia":          "AS",
+	"Europe":        "EU",
+	"North America": "NA",
+	"Oceania":       "OC",
+	"South America": "SA",
+}
+
+// genCountryInfo writes calling codes, TLDs, capitals and continents from
+// REST Countries and region codes from the UN M.49 standard.
+func genCountryInfo(b *bytes.Buffer) {
+	var list []struct {
+		CCA2 string   `json:"cca2"`
+		TLD  []string `json:"tld"`
+		IDD  struct {
+			Root     string   `json:"root"`
+			Suffixes []string `json:"suffixes"`
+		} `json:"idd"`
+		Capital    []string `json:"capital"`
+		Continents []string `json:"continents"`
+	}
+	f := open("data/restcountries.json")
+	if err := json.NewDecoder(f).Decode(&list); err != nil {
+		log.Fatalf("data/restcountries.json: %v", err)
+	}
+	f.Close()
+	sort.Slice(list, func(i, j int) bool { return list[i].CCA2 < list[j].CCA2 })
+
+	m49 := make(map[string]map[string]string)
+	names := make(map[string]string)
+	for _, row := range readCSV("data/unsd_m49.csv", ';') {
+		m49[row["ISO-alpha2 Code"]] = row
+		for _, k := range []string{"Global", "Region", "Sub-region", "Intermediate Region"} {
+			if c := row[k+" Code"]; c != "" {
+				names[c] = row[k+" Name"]
+			}
+		}
+	}
+
+	b.WriteString("var country_info = map[string]CountryInfo{\n")
+	for _, v := range list {
+		codes := make([]string, 0)
+		for _, s := range v.IDD.Suffixes {
+			codes = append(codes, strconv.Quote(v.IDD.Root+s))
+		}
+		var tld, capital, continent string
+		if len(v.TLD) > 0 {
+			tld = v.TLD[0]
+		}
+		if len(v.Capital) > 0 {
+			capital = v.Capital[0]
+		}
+		if len(v.Continents) > 0 {
+			var ok bool
+			if continent, ok = continents[v.Continents[0]]; !ok {
+				log.Fatalf("data/restcountries.json: %s: unknown continent %q", v.CCA2, v.Continents[0])
+			}
+		}
+		row := m49[v.CCA2]
+		fmt.Fprintf(b, "\t%q: {[]string{%s}, %q, %q, %q, %q, %q, %q},\n",
+			v.CCA2, strings.Join(codes, ", "), tld, capital, continent,
+			row["Region Code"], row["Sub-region Code"], row["Intermediate Region Code"])
+	}
+	b.WriteString("}\n\n")
+
+	codes := make([]string, 0, len(names))
+	for c := range names {
+		codes = append(codes, c)
+	}
+	sort.Strings(codes)
+	b.WriteString("// UN M.49 region names\n")
+	b.WriteString("// https://unstats.un.org/unsd/methodology/m49/\n")
+	b.WriteString("var un_region_names = map[string]string{\n")
+	for _, c := range codes {
+		fmt.Fprintf(b, "\t%q: %q,\n", c, names[c])
+	}
+	b.WriteString("}\n\n")
+}
+
+// genRegions writes ISO 3166-2 subdivision names, types and parents from
+// Debian's iso-codes and CLDR subdivision names for all languages in
+// data/cldr.
+// genRegions writes ISO 3166-2 subdivisions and their CLDR names. It
+// returns the set of known region codes.
+func genRegions(b *bytes.Buffer, languages []iso639) map[string]bool {
+	var codes map[string][]struct {
+		Code   string `json:"code"`
+		Name   string `json:"name"`
+		Type   string `json:"type"`
+		Parent string `json:"parent"`
+	}
+	f := open("data/iso_3166-2.json")
+	if err := json.NewDecoder(f).Decode(&codes); err != nil {
+		log.Fatalf("data/iso_3166-2.json: %v", err)
+	}
+	f.Close()
+	list := codes["3166-2"]
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+	known := make(map[string]bool, len(list))
+	for _, v := range list {
+		known[v.Code] = true
+	}
+
+	b.WriteString("// ISO 3166-2 subdivisions. Names are in the local language, romanised\n")
+	b.WriteString("// for non-Latin scripts.\n")
+	b.WriteString("// https://en.wikipedia.org/wiki/ISO_3166-2\n")
+	b.WriteString("var region_info = map[string]RegionInfo{\n")
+	for _, v := range list {
+		var parent string
+		if v.Parent != "" {
+			// older releases omit the country prefix
+			parent = v.Parent
+			if !strings.Contains(parent, "-") {
+				parent = v.Code[:3] + parent
+			}
+			if !known[parent] {
+				log.Fatalf("data/iso_3166-2.json: %s: unknown parent %q", v.Code, v.Parent)
+			}
+		}
+		// upstream capitalisation of types is inconsistent
+		typ := strings.ToUpper(v.Type[:1]) + v.Type[1:]
+		fmt.Fprintf(b, "\t%q: {%q, %q, %q},\n", v.Code, v.Name, typ, parent)
+	}
+	b.WriteString("}\n\n")
+
+	alpha2 := make(map[string]string)
+	for _, l := range languages {
+		if l.Alpha2 != "" {
+			alpha2[l.Alpha2] = l.Code()
+		}
+	}
+	files, err := filepath.Glob("data/cldr/*/subdivisions.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	names := make([]string, 0)
+	for _, name := range files {
+		var doc struct {
+			Main map[string]struct {
+				Names struct {
+					Subdivisions struct {
+						Subdivision map[string]string `json:"subdivision"`
+					} `json:"subdivisions"`
+				} `json:"localeDisplayNames"`
+			} `json:"main"`
+		}
+		f := open(name)
+		if err := json.NewDecoder(f).Decode(&doc); err != nil {
+			log.Fatalf("%s: %v", name, err)
+		}
+		f.Close()
+		for locale, v := range doc.Main {
+			lang, ok := alpha2[locale]
+			if !ok {
+				log.Fatalf("%s: unsupported locale %q", name, locale)
+			}
+			for key, n := range v.Names.Subdivisions.Subdivision {
+				// CLDR uses lower case codes without separator, e.g. deby
+				code := strings.ToUpper(key[:2] + "-" + key[2:])
+				if !known[code] {
+					continue
+				}
+				names = append(names, fmt.Sprintf("\t%q: %q,\n", lang+":"+code, n))
+			}
+		}
+	}
+	sort.Strings(names)
+	b.WriteString("// CLDR subdivision names keyed by ISO 639-2/T language and region code.\n")
+	b.WriteString("var region_names_cldr = map[string]string{\n")
+	for _, n := range names {
+		b.WriteString(n)
+	}
+	b.WriteString("}\n\n")
+	return known
+}
+
+// regionAliases maps withdrawn ISO 3166-2 codes and the codes OurAirports
+// still uses to current codes. Regions that were split map to the
+// subdivision containing their former capital.
+var regionAliases = map[string]string{
+	// Bangladesh, numeric division codes until 2011
+	"BD-1": "BD-A", "BD-2": "BD-B", "BD-3": "BD-C", "BD-4": "BD-D", "BD-5": "BD-E", "BD-6": "BD-G",
+	// China, numeric province codes until 2017
+	"CN-11": "CN-BJ", "CN-12": "CN-TJ", "CN-13": "CN-HE", "CN-14": "CN-SX", "CN-15": "CN-NM",
+	"CN-21": "CN-LN", "CN-22": "CN-JL", "CN-23": "CN-HL", "CN-31": "CN-SH", "CN-32": "CN-JS",
+	"CN-33": "CN-ZJ", "CN-34": "CN-AH", "CN-35": "CN-FJ", "CN-36": "CN-JX", "CN-37": "CN-SD",
+	"CN-41": "CN-HA", "CN-42": "CN-HB", "CN-43": "CN-HN", "CN-44": "CN-GD", "CN-45": "CN-GX",
+	"CN-46": "CN-HI", "CN-50": "CN-CQ", "CN-51": "CN-SC", "CN-52": "CN-GZ", "CN-53": "CN-YN",
+	"CN-54": "CN-XZ", "CN-61": "CN-SN", "CN-62": "CN-GS", "CN-63": "CN-QH", "CN-64": "CN-NX",
+	"CN-65": "CN-XJ", "CN-71": "CN-TW", "CN-91": "CN-HK", "CN-92": "CN-MO",
+	// Czechia, letter region codes until 2016
+	"CZ-PR": "CZ-10", "CZ-ST": "CZ-20", "CZ-JC": "CZ-31", "CZ-PL": "CZ-32", "CZ-KA": "CZ-41",
+	"CZ-US": "CZ-42", "CZ-LI": "CZ-51", "CZ-KR": "CZ-52", "CZ-PA": "CZ-53", "CZ-VY": "CZ-63",
+	"CZ-JM": "CZ-64", "CZ-OL": "CZ-71", "CZ-ZL": "CZ-72", "CZ-MO": "CZ-80",
+	// Germany, OurAirports
+	"DE-BR": "DE-BB",
+	// Finland, provinces until 2010
+	"FI-AL": "FI-01", "FI-ES": "FI-18", "FI-IS": "FI-04", "FI-LL": "FI-10", "FI-LS": "FI-19", "FI-OL": "FI-14",
+	// Greece, prefectures until 2011
+	"GR-01": "GR-G", "GR-03": "GR-H", "GR-04": "GR-H", "GR-05": "GR-H", "GR-06": "GR-H",
+	"GR-07": "GR-H", "GR-11": "GR-J", "GR-12": "GR-J", "GR-13": "GR-G", "GR-14": "GR-G",
+	"GR-15": "GR-J", "GR-16": "GR-J", "GR-17": "GR-J", "GR-21": "GR-F", "GR-22": "GR-F",
+	"GR-23": "GR-F", "GR-24": "GR-F", "GR-31": "GR-D", "GR-32": "GR-D", "GR-33": "GR-D",
+	"GR-34": "GR-D", "GR-41": "GR-E", "GR-42": "GR-E", "GR-43": "GR-E", "GR-44": "GR-E",
+	"GR-51": "GR-C", "GR-52": "GR-A", "GR-53": "GR-B", "GR-54": "GR-B", "GR-55": "GR-A",
+	"GR-56": "GR-C", "GR-57": "GR-B", "GR-58": "GR-C", "GR-59": "GR-B", "GR-61": "GR-B",
+	"GR-62": "GR-B", "GR-63": "GR-C", "GR-64": "GR-B", "GR-71": "GR-A", "GR-72": "GR-A",
+	"GR-73": "GR-A", "GR-81": "GR-L", "GR-82": "GR-L", "GR-83": "GR-K", "GR-84": "GR-K",
+	"GR-85": "GR-K", "GR-91": "GR-M", "GR-92": "GR-M", "GR-93": "GR-M", "GR-94": "GR-M",
+	"GR-A1": "GR-I",
+	// India, OurAirports
+	"IN-MM": "IN-MH",
+	// Kenya, provinces until 2013
+	"KE-110": "KE-30", "KE-300": "KE-28",
+	// Luxembourg, districts until 2015
+	"LU-D": "LU-DI", "LU-G": "LU-GR", "LU-L": "LU-LU",
+	// North Macedonia, OurAirports
+	"MK-004": "MK-810",
+	// Mauritania, Nouakchott until 2017
+	"MR-NKC": "MR-14",
+	// Mexico, Distrito Federal until 2016
+	"MX-DIF": "MX-CMX",
+	// Norway, counties until 2020
+	"NO-01": "NO-30", "NO-02": "NO-30", "NO-04": "NO-34", "NO-05": "NO-34", "NO-06": "NO-30",
+	"NO-07": "NO-38", "NO-08": "NO-38", "NO-09": "NO-42", "NO-10": "NO-42", "NO-12": "NO-46",
+	"NO-14": "NO-46", "NO-16": "NO-50", "NO-17": "NO-50", "NO-19": "NO-54", "NO-20": "NO-54",
+	// Oman, Al Batinah until 2011
+	"OM-BA": "OM-BS",
+	// Poland, letter voivodeship codes until 2018
+	"PL-DS": "PL-02", "PL-KP": "PL-04", "PL-LU": "PL-06", "PL-LB": "PL-08", "PL-LD": "PL-10",
+	"PL-MA": "PL-12", "PL-MZ": "PL-14", "PL-OP": "PL-16", "PL-PK": "PL-18", "PL-PD": "PL-20",
+	"PL-PM": "PL-22", "PL-SL": "PL-24", "PL-SK": "PL-26", "PL-WN": "PL-28", "PL-WP": "PL-30",
+	"PL-ZP": "PL-32",
+	// Sudan and South Sudan, numeric state codes until 2012
+	"SD-03": "SD-KH", "SS-17": "SS-EC",
+	// Sweden, Göteborgs och Bohus län until 1998
+	"SE-Q": "SE-O",
+	// Taiwan, OurAirports
+	"TW-X-KM": "TW-KIN",
+	// Viet Nam, OurAirports
+	"VN-15": "VN-HN", "VN-60": "VN-DN",
+	// South Africa, Natal until 1994
+	"ZA-NL": "ZA-KZN",
+}
+
+// genRegionAliases writes regionAliases after checking that every alias
+// is withdrawn and every target is a current code.
+func genRegionAliases(b *bytes.Buffer, known map[string]bool) {
+	codes := make([]string, 0, len(regionAliases))
+	for old, cur := range regionAliases {
+		if known[old] {
+			log.Fatalf("region alias %s: code is current", old)
+		}
+		if !known[cur] {
+			log.Fatalf("region alias %s: unknown region %s", old, cur)
+		}
+		codes = append(codes, old)
+	}
+	sort.Strings(codes)
+	b.WriteString("// Withdrawn ISO 3166-2 codes and their current replacements.\n")
+	b.WriteString("var region_aliases = map[string]Region{\n")
+	for _, c := range codes {
+		fmt.Fprintf(b, "\t%q: %q,\n", c, regionAliases[c])
+	}
+	b.WriteString("}\n\n")
+}
+
+// yamlValue splits a line of a flat YAML mapping into key and unquoted
+// value.
+func yamlValue(line string) (string, string, bool) {
+	kv := strings.SplitN(strings.TrimSpace(line), ":", 2)
+	if len(kv) != 2 {
+		return "", "", false
+	}
+	return kv[0], strings.Trim(strings.TrimSpace(kv[1]), `"'`), true
+}
+
+// genRegionCoordinates writes centroids and bounding boxes of the regions
+// in known from the gountries subdivision files. The files are a flat YAML
+// list, so only the few keys used here are parsed line by line. Boxes
+// crossing the antimeridian or not containing their centroid are dropped.
+func genRegionCoordinates(b *bytes.Buffer, known map[string]bool) {
+	type coords struct {
+		lat, lon, south, west, north, east float64
+	}
+	files, err := filepath.Glob("data/gountries/subdivisions/*.yaml")
+	if err != nil {
+		log.Fatal(err)
+	}
+	list := make(map[string]coords)
+	for _, name := range files {
+		f := open(name)
+		var (
+			code, country string
+			c             coords
+		)
+		flush := func() {
+			if code == "" || country == "" {
+				return
+			}
+			if k := country + "-" + code; known[k] {
+				list[k] = c
+			}
+		}
+		s := bufio.NewScanner(f)
+		for s.Scan() {
+			line := s.Text()
+			if strings.HasPrefix(line, "- ") {
+				flush()
+				code, country, c = "", "", coords{}
+				line = "  " + line[2:]
+			}
+			key, val, ok := yamlValue(line)
+			if !ok {
+				continue
+			}
+			var dst *float64
+			switch key {
+			case "code":
+				code = val
+			case "countryalpha2":
+				country = val
+			case "latitude":
+				dst = &c.lat
+			case "longitude":
+				dst = &c.lon
+			case "minlatitude":
+				dst = &c.south
+			case "minlongitude":
+				dst = &c.west
+			case "maxlatitude":
+				dst = &c.north
+			case "maxlongitude":
+				dst = &c.east
+			}
+			if dst != nil {
+				if *dst, err = strconv.ParseFloat(val, 64); err != nil {
+					log.Fatalf("%s: %s-%s: %v", name, country, code, err)
+				}
+			}
+		}
+		if err := s.Err(); err != nil {
+			log.Fatalf("%s: %v", name, err)
+		}
+		flush()
+		f.Close()
+	}
+	codes := make([]string, 0, len(list))
+	for k := range list {
+		codes = append(codes, k)
+	}
+	sort.Strings(codes)
+
+	round := func(f float64) string {
+		return strconv.FormatFloat(math.Round(f*1e4)/1e4, 'f', -1, 64)
+	}
+	b.WriteString("// Region centroids as latitude, longitude.\n")
+	b.WriteString("// https://github.com/pariz/gountries\n")
+	b.WriteString("var region_gps = map[string][2]float64{\n")
+	for _, k := range codes {
+		if c := list[k]; c.lat != 0 || c.lon != 0 {
+			fmt.Fprintf(b, "\t%q: {%s, %s},\n", k, round(c.lat), round(c.lon))
+		}
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("// Region bounding boxes as south, west, north, east.\n")
+	b.WriteString("var region_bounds = map[string]BoundingBox{\n")
+	for _, k := range codes {
+		c := list[k]
+		if c.south >= c.north || c.west >= c.east {
+			continue
+		}
+		if c.lat < c.south || c.lat > c.north || c.lon < c.west || c.lon > c.east {
+			continue
+		}
+		fmt.Fprintf(b, "\t%q: {%s, %s, %s, %s},\n", k, round(c.south), round(c.west), round(c.north), round(c.east))
+	}
+	b.WriteString("}\n\n")
+}
+
+// The last two letters of an ISO 3166-3 code name the successor unless
+// the territory was split (HH), the successor received a new code (XX)
+// or the code was kept (AA).
+var formerSuccessors = map[string][]string{
+	"ANHH": {"BQ", "CW", "SX"},
+	"BYAA": {"BY"},
+	"CSHH": {"CZ", "SK"},
+	"CSXX": {"ME", "RS"},
+	"FQHH": {"AQ", "TF"},
+	"GEHH": {"KI", "TV"},
+	"NTHH": {"IQ", "SA"},
+	"PCHH": {"FM", "MH", "MP", "PW"},
+	"SUHH": {"AM", "AZ", "BY", "EE", "GE", "KG", "KZ", "LT", "LV", "MD", "RU", "TJ", "TM", "UA", "UZ"},
+}
+
+type formerCountry struct {
+	alpha2, alpha4, name string
+	withdrawn            time.Time
+	successors           []string
+}
+
+// parseDate parses dates with year, month or day precision. Missing parts
+// default to the first month or day.
+func parseDate(s string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02", "2006-01", "2006"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", s)
+}
+
+func dateLiteral(t time.Time) string {
+	return fmt.Sprintf("date(%d, %d, %d)", t.Year(), t.Month(), t.Day())
+}
+
+// genFormerCountries writes ISO 3166-3 codes for country names removed
+// from ISO 3166-1 from Debian's iso-codes and returns them ordered by
+// alpha-4 code.
+func genFormerCountries(b *bytes.Buffer) []formerCountry {
+	var codes map[string][]struct {
+		Alpha2         string `json:"alpha_2"`
+		Alpha4         string `json:"alpha_4"`
+		Name           string `json:"name"`
+		WithdrawalDate string `json:"withdrawal_date"`
+	}
+	f := open("data/iso_3166-3.json")
+	if err := json.NewDecoder(f).Decode(&codes); err != nil {
+		log.Fatalf("data/iso_3166-3.json: %v", err)
+	}
+	f.Close()
+	list := codes["3166-3"]
+	sort.Slice(list, func(i, j int) bool { return list[i].Alpha4 < list[j].Alpha4 })
+
+	b.WriteString("// ISO 3166-3 codes for formerly used country names keyed by alpha-4 code.\n")
+	b.WriteString("// https://en.wikipedia.org/wiki/ISO_3166-3\n")
+	b.WriteString("var iso_3166_3_codes = map[string]FormerCountry{\n")
+	former := make([]formerCountry, 0, len(list))
+	for _, v := range list {
+		if len(v.Alpha4) != 4 || v.Alpha4[:2] != v.Alpha2 {
+			log.Fatalf("data/iso_3166-3.json: %s: invalid alpha-4 code %q", v.Alpha2, v.Alpha4)
+		}
+		succ, ok := formerSuccessors[v.Alpha4]
+		if !ok {
+			switch s := v.Alpha4[2:]; s {
+			case "HH", "XX", "AA":
+				log.Fatalf("data/iso_3166-3.json: %s: unknown successors", v.Alpha4)
+			default:
+				succ = []string{s}
+			}
+		}
+		t, err := parseDate(v.WithdrawalDate)
+		if err != nil {
+			log.Fatalf("data/iso_3166-3.json: %s: %v", v.Alpha4, err)
+		}
+		quoted := make([]string, len(succ))
+		for i, s := range succ {
+			quoted[i] = strconv.Quote(s)
+		}
+		fmt.Fprintf(b, "\t%q: {%q, %q, %s, []string{%s}},\n",
+			v.Alpha4, v.Alpha2, v.Name, dateLiteral(t), strings.Join(quoted, ", "))
+		former = append(former, formerCountry{v.Alpha2, v.Alpha4, v.Name, t, succ})
+	}
+	b.WriteString("}\n\n")
+	return former
+}
+
+// Codes ISO 3166-3 lists as successors which were assigned in the first
+// edition of ISO 3166-1 already.
+var countryPredecessors = map[string]bool{
+	"AQ": true, "DE": true, "FR": true, "IN": true, "IQ": true,
+	"PA": true, "SA": true, "UA": true, "VN": true, "YE": true,
+}
+
+// Codes added to ISO 3166-1 without the withdrawal of another code. Dates
+// with year precision use January 1.
+var countryAdditions = map[string]string{
+	"AI": "1985",
+	"AX": "2004-02-13",
+	"BA": "1992",
+	"BL": "2007-09-21",
+	"ER": "1993",
+	"GG": "2006-03-29",
+	"GS": "1993",
+	"HR": "1992",
+	"IM": "2006-03-29",
+	"JE": "2006-03-29",
+	"MF": "2007-09-21",
+	"MK": "1993",
+	"PS": "1999",
+	"SI": "1992",
+	"SS": "2011-08-09",
+	"YT": "1993",
+}
+
+// genCountryHistory writes the assignment periods of ISO 3166-1 codes.
+// Withdrawn codes are taken from ISO 3166-3, a successor is assigned from
+// the earliest withdrawal of a code it succeeds.
+func genCountryHistory(b *bytes.Buffer, codes []string, names map[string]string, former []formerCountry) {
+	// assigned returns the earliest withdrawal before t of a former code
+	// succeeded by c
+	assigned := func(c string, t time.Time) time.Time {
+		var from time.Time
+		for _, f := range former {
+			if !f.withdrawn.Before(t) || (!from.IsZero() && !f.withdrawn.Before(from)) {
+				continue
+			}
+			for _, s := range f.successors {
+				if s == c {
+					from = f.withdrawn
+				}
+			}
+		}
+		return from
+	}
+	var list []Assignment
+	for _, f := range former {
+		list = append(list, Assignment{f.alpha2, f.name, assigned(f.alpha2, f.withdrawn), f.withdrawn})
+	}
+	for _, c := range codes {
+		var from time.Time
+		if !countryPredecessors[c] {
+			from = assigned(c, time.Now())
+		}
+		if v, ok := countryAdditions[c]; ok {
+			if !from.IsZero() {
+				log.Fatalf("data/iso_3166-3.json: %s: added code succeeds a withdrawn code", c)
+			}
+			t, err := parseDate(v)
+			if err != nil {
+				log.Fatalf("countryAdditions: %s: %v", c, err)
+			}
+			from = t
+		}
+		if !from.IsZero() {
+			list = append(list, Assignment{c, names[c], from, time.Time{}})
+		}
+	}
+	b.WriteString("// Changes to the ISO 3166-1 code list. Codes not listed here have been\n")
+	b.WriteString("// assigned since the first edition. Codes may be reassigned, so there can\n")
+	b.WriteString("// be more than one entry per code.\n")
+	b.WriteString("// https://en.wikipedia.org/wiki/ISO_3166-3\n")
+	genAssignments(b, "iso_3166_1_history", list)
+}
+
+// Assignment mirrors the package type of the same name.
+type Assignment struct {
+	Code, Name string
+	From, To   time.Time
+}
+
+// genAssignments writes history entries ordered by code and start of the
+// assignment.
+func genAssignments(b *bytes.Buffer, name string, list []Assignment) {
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Code != list[j].Code {
+			return list[i].Code < list[j].Code
+		}
+		if !list[i].From.Equal(list[j].From) {
+			return list[i].From.Before(list[j].From)
+		}
+		return !list[i].To.IsZero() && (list[j].To.IsZero() || list[i].To.Before(list[j].To))
+	})
+	fmt.Fprintf(b, "var %s = []Assignment{\n", name)
+	for _, v := range list {
+		fmt.Fprintf(b, "\t{Code: %q, Name: %q", v.Code, v.Name)
+		if !v.From.IsZero() {
+			fmt.Fprintf(b, ", From: %s", dateLiteral(v.From))
+		}
+		if !v.To.IsZero() {
+			fmt.Fprintf(b, ", To: %s", dateLiteral(v.To))
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("}\n\n")
+}
+
+// Natural Earth has no ISO code for some disputed areas. Map them by their
+// ADM0_A3 code to the country that claims them or to a user-assigned code.
+// ISO 639-3 codes used by gountries for languages that ISO 639-2 only
+// covers as part of a macrolanguage.
+var macroLanguages = map[string]string{
+	"cmn": "zho", // Mandarin
+}
+
+// genCountryLanguages writes the official languages of each country from
+// its gountries file. Languages without an ISO 639-2 code are dropped.
+func genCountryLanguages(b *bytes.Buffer, countries []string, languages []iso639) {
+	known := make(map[string]bool, len(languages))
+	for _, l := range languages {
+		known[l.Code()] = true
+	}
+	b.WriteString("// Official languages of countries as ISO 639-2/T codes.\n")
+	b.WriteString("// https://github.com/pariz/gountries\n")
+	b.WriteString("var country_languages = map[string][]Language{\n")
+	for _, c := range countries {
+		name := "data/gountries/countries/" + strings.ToLower(c) + ".yaml"
+		if _, err := os.Stat(name); err != nil {
+			continue
+		}
+		f := open(name)
+		list := make([]string, 0)
+		inList := false
+		sc := bufio.NewScanner(f)
+		for sc.Scan() {
+			line := sc.Text()
+			if !strings.HasPrefix(line, " ") {
+				inList = line == "languages:"
+				continue
+			}
+			key, _, ok := yamlValue(line)
+			if !inList || !ok {
+				continue
+			}
+			if l, ok := macroLanguages[key]; ok {
+				key = l
+			}
+			if known[key] {
+				list = append(list, strconv.Quote(key))
+			}
+		}
+		if err := sc.Err(); err != nil {
+			log.Fatalf("%s: %v", name, err)
+		}
+		f.Close()
+		if len(list) > 0 {
+			fmt.Fprintf(b, "\t%q: {%s},\n", c, strings.Join(list, ", "))
+		}
+	}
+	b.WriteString("}\n\n")
+}
+
+var naturalEarthCodes = map[string]string{
+	"CYN": "CY", // Northern Cyprus
+	"KOS": "XK", // Kosovo
+	"SOL": "SO", // Somaliland
+}
+
+// smallCountryBounds returns the bounding box of a country too small for
+// Natural Earth's 1:110m scale from its gountries file. The upstream boxes
+// of archipelagos span wide areas of sea and some are plainly wrong, e.g.
+// Singapore's covers half of Johor. The box is therefore clipped to a
+// square around the centroid with sides twice as long as those of a
+// square of the country's area.
+func smallCountryBounds(code string) ([4]float64, bool) {
+	name := "data/gountries/countries/" + strings.ToLower(code) + ".yaml"
+	if _, err := os.Stat(name); err != nil {
+		return [4]float64{}, false
+	}
+	f := open(name)
+	defer f.Close()
+	var lat, lon, area, s, w, n, e float64
+	keys := map[string]*float64{
+		"latitude":     &lat,
+		"longitude":    &lon,
+		"area":         &area,
+		"minlatitude":  &s,
+		"minlongitude": &w,
+		"maxlatitude":  &n,
+		"maxlongitude": &e,
+	}
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		key, val, ok := yamlValue(sc.Text())
+		if dst := keys[key]; ok && dst != nil {
+			v, err := strconv.ParseFloat(val, 64)
+			if err != nil {
+				log.Fatalf("%s: %s: %v", name, key, err)
+			}
+			*dst = v
+		}
+	}
+	if err := sc.Err(); err != nil {
+		log.Fatalf("%s: %v", name, err)
+	}
+	valid := s < n && w < e
+	if area <= 0 {
+		return [4]float64{s, w, n, e}, valid
+	}
+	if !valid || lat < s || lat > n || lon < w || lon > e {
+		// degenerate or antimeridian crossing box, use the square only
+		s, w, n, e = -90, -180, 90, 180
+	}
+	dlat := math.Sqrt(area) / 111.32
+	dlon := dlat / math.Cos(lat*math.Pi/180)
+	return [4]float64{
+		math.Max(s, lat-dlat),
+		math.Max(w, lon-dlon),
+		math.Min(n, lat+dlat),
+		math.Min(e, lon+dlon),
+	}, true
+}
+
+// genCountryShapes writes bounding boxes and simplified boundaries from
+// Natural Earth's 1:110m admin 0 countries. Coordinates are rounded to two
+// decimal places which is well below the precision of the source. Countries
+// without a boundary at this scale get the box from smallCountryBounds,
+// rounded outwards to three decimal places.
+func genCountryShapes(b *bytes.Buffer, countries []string) {
+	var fc struct {
+		Features []struct {
+			Properties struct {
+				Name   string `json:"name"`
+				Adm0A3 string `json:"adm0_a3"`
+				IsoA2  string `json:"iso_a2"`
+			} `json:"properties"`
+			Geometry struct {
+				Type        string          `json:"type"`
+				Coordinates json.RawMessage `json:"coordinates"`
+			} `json:"geometry"`
+		} `json:"features"`
+	}
+	const name = "data/ne_110m_admin_0_countries.geojson"
+	f := open(name)
+	if err := json.NewDecoder(f).Decode(&fc); err != nil {
+		log.Fatalf("%s: %v", name, err)
+	}
+	f.Close()
+
+	shapes := make(map[string][][][2]float64)
+	for _, v := range fc.Features {
+		code := v.Properties.IsoA2
+		if c, ok := naturalEarthCodes[v.Properties.Adm0A3]; ok {
+			code = c
+		}
+		if len(code) != 2 {
+			log.Fatalf("%s: %s: no ISO 3166-1 code", name, v.Properties.Name)
+		}
+		var polygons [][][][2]float64
+		switch v.Geometry.Type {
+		case "Polygon":
+			polygons = make([][][][2]float64, 1)
+			if err := json.Unmarshal(v.Geometry.Coordinates, &polygons[0]); err != nil {
+				log.Fatalf("%s: %s: %v", name, code, err)
+			}
+		case "MultiPolygon":
+			if err := json.Unmarshal(v.Geometry.Coordinates, &polygons); err != nil {
+				log.Fatalf("%s: %s: %v", name, code, err)
+			}
+		default:
+			log.Fatalf("%s: %s: unsupported geometry %s", name, code, v.Geometry.Type)
+		}
+		for _, p := range polygons {
+			shapes[code] = append(shapes[code], p...)
+		}
+	}
+	codes := make([]string, 0, len(shapes))
+	for c := range shapes {
+		codes = append(codes, c)
+	}
+	sort.Strings(codes)
+
+	round := func(f float64) string {
+		return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+	}
+	b.WriteString("// Country bounding boxes as south, west, north, east.\n")
+	b.WriteString("var country_bounds = map[string]BoundingBox{\n")
+	for _, c := range codes {
+		s, w, n, e := 90.0, 180.0, -90.0, -180.0
+		for _, ring := range shapes[c] {
+			for _, p := range ring {
+				w, e = math.Min(w, p[0]), math.Max(e, p[0])
+				s, n = math.Min(s, p[1]), math.Max(n, p[1])
+			}
+		}
+		fmt.Fprintf(b, "\t%q: {%s, %s, %s, %s},\n", c, round(s), round(w), round(n), round(e))
+	}
+	b.WriteString("}\n\n")
+
+	floor := func(f float64) string {
+		return strconv.FormatFloat(math.Floor(f*1000)/1000, 'f', -1, 64)
+	}
+	ceil := func(f float64) string {
+		return strconv.FormatFloat(math.Ceil(f*1000)/1000, 'f', -1, 64)
+	}
+	b.WriteString("// Bounding boxes of countries without boundary as south, west, north,\n")
+	b.WriteString("// east. CountryAt prefers them over the boundaries of their neighbours.\n")
+	b.WriteString("// https://github.com/pariz/gountries\n")
+	b.WriteString("var country_small_bounds = map[string]BoundingBox{\n")
+	for _, c := range countries {
+		if _, ok := shapes[c]; ok {
+			continue
+		}
+		if bb, ok := smallCountryBounds(c); ok {
+			fmt.Fprintf(b, "\t%q: {%s, %s, %s, %s},\n", c, floor(bb[0]), floor(bb[1]), ceil(bb[2]), ceil(bb[3]))
+		}
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("// Country boundaries as a list of rings with alternating longitude and\n")
+	b.WriteString("// latitude values. Holes are separate rings.\n")
+	b.WriteString("// https://www.naturalearthdata.com\n")
+	b.WriteString("var country_shapes = map[string][][]float64{\n")
+	for _, c := range codes {
+		rings := make([]string, 0, len(shapes[c]))
+		for _, ring := range shapes[c] {
+			vals := make([]string, 0, 2*len(ring))
+			for _, p := range ring {
+				vals = append(vals, round(p[0]), round(p[1]))
+			}
+			rings = append(rings, "{"+strings.Join(vals, ", ")+"}")
+		}
+		fmt.Fprintf(b, "\t%q: {%s},\n", c, strings.Join(rings, ", "))
+	}
+	b.WriteString("}\n\n")
+}
+
+type iso4217 struct {
+	Published string `xml:"Pblshd,attr"`
+	Entries   []struct {
+		Country string `xml:"CtryNm"`
+		Name    struct {
+			Value  string `xml:",chardata"`
+			IsFund bool   `xml:"IsFund,attr"`
+		} `xml:"CcyNm"`
+		Code       string `xml:"Ccy"`
+		Number     string `xml:"CcyNbr"`
+		MinorUnits string `xml:"CcyMnrUnts"`
+	} `xml:"CcyTbl>CcyNtry"`
+}
+
+type iso4217Historic struct {
+	Entries []struct {
+		Country   string `xml:"CtryNm"`
+		Name      string `xml:"CcyNm"`
+		Code      string `xml:"Ccy"`
+		Number    string `xml:"CcyNbr"`
+		Withdrawn string `xml:"WthdrwlDt"`
+	} `xml:"HstrcCcyTbl>HstrcCcyNtry"`
+}
+
+type historicCurrency struct {
+	name      string
+	withdrawn time.Time
+}
+
+// readHistoricCurrencies returns the codes in ISO 4217 list three with
+// the end of their last use. The list has one entry per country and use,
+// withdrawals are dated by month or by a range of years.
+func readHistoricCurrencies() map[string]historicCurrency {
+	var list iso4217Historic
+	f := open("data/list_three.xml")
+	if err := xml.NewDecoder(f).Decode(&list); err != nil {
+		log.Fatalf("data/list_three.xml: %v", err)
+	}
+	f.Close()
+
+	m := make(map[string]historicCurrency)
+	for _, v := range list.Entries {
+		if v.Code == "" {
+			continue
+		}
+		// codes stay valid until the end of the withdrawal period
+		var t time.Time
+		if years := strings.SplitN(v.Withdrawn, " to ", 2); len(years) == 2 {
+			y, err := parseDate(years[1])
+			if err != nil {
+				log.Fatalf("data/list_three.xml: %s: %v", v.Code, err)
+			}
+			t = y.AddDate(1, 0, 0)
+		} else {
+			d, err := parseDate(v.Withdrawn)
+			if err != nil {
+				log.Fatalf("data/list_three.xml: %s: %v", v.Code, err)
+			}
+			t = d.AddDate(0, 1, 0)
+		}
+		if c, ok := m[v.Code]; !ok {
+			m[v.Code] = historicCurrency{v.Name, t}
+		} else if t.After(c.withdrawn) {
+			c.withdrawn = t
+			m[v.Code] = c
+		}
+	}
+	return m
+}
+
+// Introduction of codes assigned after the first edition of ISO 4217.
+// Dates with month precision use the first day of the month.
+var currencyIntroductions = map[string]string{
+	"AMD": "1993-11",
+	"AOA": "1999-12",
+	"ARS": "1992-01",
+	"AZN": "2006-01",
+	"BAM": "1998-07",
+	"BGN": "1999-07",
+	"BRL": "1994-07",
+	"BYN": "2016-07",
+	"BYR": "2000-01",
+	"CDF": "1998-07",
+	"CSD": "2003-07",
+	"CZK": "1993-02",
+	"EEK": "1992-06",
+	"ERN": "1997-11",
+	"EUR": "1999-01",
+	"GEL": "1995-10",
+	"GHS": "2007-07",
+	"HRK": "1994-05",
+	"KGS": "1993-05",
+	"KZT": "1993-11",
+	"LTL": "1993-06",
+	"LVL": "1993-03",
+	"MDL": "1993-11",
+	"MGA": "2005-01",
+	"MRU": "2018-01",
+	"MXN": "1993-01",
+	"MZN": "2006-07",
+	"PEN": "1991-07",
+	"PLN": "1995-01",
+	"RON": "2005-07",
+	"RSD": "2006-10",
+	"RUB": "1998-01",
+	"SDG": "2007-07",
+	"SIT": "1991-10",
+	"SKK": "1993-02",
+	"SLE": "2022-07",
+	"SRD": "2004-01",
+	"SSP": "2011-07-18",
+	"STN": "2018-01",
+	"TJS": "2000-10",
+	"TMT": "2009-01",
+	"TRY": "2005-01",
+	"UAH": "1996-09",
+	"UYU": "1993-03",
+	"UYW": "2018-08-29",
+	"UZS": "1994-07",
+	"VED": "2021-10",
+	"VEF": "2008-01",
+	"VES": "2018-08-20",
+	"XCG": "2025-03-31",
+	"ZMW": "2013-01",
+	"ZWG": "2024-06-25",
+	"ZWL": "2009-02",
+	"ZWN": "2006-08",
+	"ZWR": "2008-08",
+}
+
+// genCurrencyHistory writes the assignment periods of ISO 4217 codes from
+// list three and the introduction dates of current codes.
+func genCurrencyHistory(b *bytes.Buffer, current map[string]string, historic map[string]historicCurrency) {
+	var list []Assignment
+	from := func(c string) time.Time {
+		v, ok := currencyIntroductions[c]
+		if !ok {
+			return time.Time{}
+		}
+		t, err := parseDate(v)
+		if err != nil {
+			log.Fatalf("currencyIntroductions: %s: %v", c, err)
+		}
+		return t
+	}
+	for c, v := range historic {
+		// list three also records withdrawals of current codes from
+		// single countries
+		if _, ok := current[c]; !ok {
+			list = append(list, Assignment{c, v.name, from(c), v.withdrawn})
+		}
+	}
+	for c, name := range current {
+		if t := from(c); !t.IsZero() {
+			list = append(list, Assignment{c, name, t, time.Time{}})
+		}
+	}
+	for c := range currencyIntroductions {
+		_, ok := current[c]
+		if _, old := historic[c]; !ok && !old {
+			log.Fatalf("currencyIntroductions: %s: unknown code", c)
+		}
+	}
+	b.WriteString("// Changes to the ISO 4217 code list. Codes not listed here have been\n")
+	b.WriteString("// assigned since the first edition.\n")
+	b.WriteString("// https://en.wikipedia.org/wiki/ISO_4217#Historical_codes\n")
+	genAssignments(b, "iso_4217_history", list)
+}
+
+type rubyCurrency struct {
+	IsoCode            string   `json:"iso_code"`
+	Name               string   `json:"name"`
+	Symbol             string   `json:"symbol"`
+	AlternateSymbols   []string `json:"alternate_symbols"`
+	Subunit            string   `json:"subunit"`
+	SubunitToUnit      int64    `json:"subunit_to_unit"`
+	SymbolFirst        bool     `json:"symbol_first"`
+	HTMLEntity         string   `json:"html_entity"`
+	DecimalMark        string   `json:"decimal_mark"`
+	ThousandsSeparator string   `json:"thousands_separator"`
+	IsoNumeric         string   `json:"iso_numeric"`
+}
+
+// ISO 4217 codes without minor unit that are not currencies in the usual
+// sense. Fund codes are marked in the list itself.
+var specialCurrencies = map[string]string{
+	"XAG": "metal",
+	"XAU": "metal",
+	"XPD": "metal",
+	"XPT": "metal",
+	"XBA": "unit",
+	"XBB": "unit",
+	"XBC": "unit",
+	"XBD": "unit",
+	"XDR": "unit",
+	"XSU": "unit",
+	"XUA": "unit",
+	"XTS": "test",
+	"XXX": "none",
+}
+
+type cldrFraction struct {
+	Digits       string `json:"_digits"`
+	Rounding     string `json:"_rounding"`
+	CashDigits   string `json:"_cashDigits"`
+	CashRounding string `json:"_cashRounding"`
+}
+
+// readCurrencyFractions returns the CLDR cash precision and rounding
+// increment per currency and the CLDR version.
+func readCurrencyFractions() (map[string][2]int, string) {
+	var data struct {
+		Supplemental struct {
+			Version struct {
+				CLDR string `json:"_cldrVersion"`
+			} `json:"version"`
+			CurrencyData struct {
+				Fractions map[string]cldrFraction `json:"fractions"`
+			} `json:"currencyData"`
+		} `json:"supplemental"`
+	}
+	f := open("data/cldr/supplemental/currencyData.json")
+	if err := json.NewDecoder(f).Decode(&data); err != nil {
+		log.Fatalf("data/cldr/supplemental/currencyData.json: %v", err)
+	}
+	f.Close()
+	cash := make(map[string][2]int)
+	for k, v := range data.Supplemental.CurrencyData.Fractions {
+		digits, rounding := v.Digits, v.Rounding
+		if v.CashDigits != "" {
+			digits = v.CashDigits
+		}
+		if v.CashRounding != "" {
+			rounding = v.CashRounding
+		}
+		d, _ := strconv.Atoi(digits)
+		r, _ := strconv.Atoi(rounding)
+		if r == 0 {
+			r = 1
+		}
+		cash[k] = [2]int{d, r}
+	}
+	return cash, data.Supplemental.Version.CLDR
+}
+
+// genCurrencies writes current ISO 4217 codes and currency formatting
+// data. It returns the current codes with their names, the publication
+// date of list one and the CLDR version.
+func genCurrencies(b *bytes.Buffer, historic map[string]historicCurrency) (map[string]string, string, string) {
+	var list iso4217
+	f := open("data/list_one.xml")
+	if err := xml.NewDecoder(f).Decode(&list); err != nil {
+		log.Fatalf("data/list_one.xml: %v", err)
+	}
+	f.Close()
+
+	// the list contains one entry per country, keep the first one per code
+	names := make(map[string]string)
+	units := make(map[string]int)
+	numbers := make(map[string]string)
+	categories := make(map[string]string)
+	codes := make([]string, 0)
+	for _, v := range list.Entries {
+		if v.Code == "" {
+			continue
+		}
+		if _, ok := names[v.Code]; ok {
+			continue
+		}
+		codes = append(codes, v.Code)
+		names[v.Code] = v.Name.Value
+		numbers[v.Code] = v.Number
+		if n, err := strconv.Atoi(v.MinorUnits); err == nil {
+			units[v.Code] = n
+		} else if v.MinorUnits == "N.A." {
+			units[v.Code] = 0
+		}
+		if v.Name.IsFund {
+			categories[v.Code] = "fund"
+		} else if c, ok := specialCurrencies[v.Code]; ok {
+			categories[v.Code] = c
+		}
+	}
+	sort.Strings(codes)
+
+	published := list.Published
+	if published == "" {
+		published = seed
+	}
+	fmt.Fprintf(b, "// ISO 4217 Currency codes (%s)\n", published)
+	b.WriteString("// https://en.wikipedia.org/wiki/ISO_4217\n")
+	b.WriteString("var (\n\tISO_4217_CURRENCY_CODES []string = []string{\n")
+	for _, c := range codes {
+		fmt.Fprintf(b, "\t\t%q, // %s\n", c, names[c])
+	}
+	b.WriteString("\t}\n)\n\n")
+
+	fractions, version := readCurrencyFractions()
+
+	var ruby map[string]rubyCurrency
+	f = open("data/currency_iso.json")
+	if err := json.NewDecoder(f).Decode(&ruby); err != nil {
+		log.Fatalf("data/currency_iso.json: %v", err)
+	}
+	f.Close()
+	// add ISO codes without formatting data using the ISO name and minor
+	// units
+	for _, c := range codes {
+		if _, ok := ruby[strings.ToLower(c)]; !ok {
+			ruby[strings.ToLower(c)] = rubyCurrency{
+				IsoCode:            c,
+				IsoNumeric:         numbers[c],
+				Name:               names[c],
+				Symbol:             c,
+				ThousandsSeparator: ",",
+				DecimalMark:        ".",
+			}
+		}
+	}
+	keys := make([]string, 0, len(ruby))
+	for k := range ruby {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	b.WriteString("var currencies = map[string]currency{\n")
+	for _, k := range keys {
+		v := ruby[k]
+		code := strings.ToUpper(v.IsoCode)
+		if n := numbers[code]; n != "" {
+			v.IsoNumeric = n
+		}
+		num, _ := strconv.Atoi(v.IsoNumeric)
+		// prefer ISO minor units, fall back to the number of decimal
+		// digits in subunit_to_unit for non-ISO currencies
+		prec, ok := units[code]
+		if !ok && v.SubunitToUnit > 0 {
+			prec = int(math.Round(math.Log10(float64(v.SubunitToUnit))))
+		} else if !ok {
+			prec = 2
+		}
+		if v.SubunitToUnit == 0 {
+			v.SubunitToUnit = int64(math.Pow10(prec))
+		}
+		// cash rounding defaults to the electronic precision for
+		// currencies without CLDR fraction data
+		cash, ok := fractions[code]
+		if !ok {
+			cash = [2]int{prec, 1}
+		}
+		alt := make([]string, len(v.AlternateSymbols))
+		for i, s := range v.AlternateSymbols {
+			alt[i] = strconv.Quote(s)
+		}
+		_, iso := names[code]
+		if _, ok := historic[code]; ok {
+			iso = true
+		}
+		fmt.Fprintf(b, "\t%q: currency{%d, %q, %q, %t, []string{%s}, %q, %q, %q, %d, %d, %d, %d, %q, %t, %q},\n",
+			code, num, v.Name, v.Symbol, v.SymbolFirst, strings.Join(alt, ", "),
+			v.ThousandsSeparator, v.DecimalMark, v.Subunit, v.SubunitToUnit, prec, cash[0], cash[1],
+			v.HTMLEntity, !iso, categories[code])
+	}
+	b.WriteString("}\n\n")
+	return names, published, version
+}
+
+type iso639 struct {
+	Bib, Term, Alpha2, Name string
+}
+
+func (l iso639) Code() string {
+	if l.Term != "" {
+		return l.Term
+	}
+	return l.Bib
+}
+
+func readLanguages() []iso639 {
+	var doc map[string][]struct {
+		Alpha2        string `json:"alpha_2"`
+		Alpha3        string `json:"alpha_3"`
+		Bibliographic string `json:"bibliographic"`
+		Name          string `json:"name"`
+	}
+	f := open("data/iso_639-2.json")
+	if err := json.NewDecoder(f).Decode(&doc); err != nil {
+		log.Fatalf("data/iso_639-2.json: %v", err)
+	}
+	f.Close()
+	list := make([]iso639, 0, len(doc["639-2"]))
+	for _, v := range doc["639-2"] {
+		// skip ranges like qaa-qtz
+		if len(v.Alpha3) != 3 {
+			continue
+		}
+		// alpha_3 is the terminology code, bibliographic codes are
+		// listed only where they differ
+		if v.Bibliographic != "" {
+			list = append(list, iso639{v.Bibliographic, v.Alpha3, v.Alpha2, v.Name})
+		} else {
+			list = append(list, iso639{v.Alpha3, "", v.Alpha2, v.Name})
+		}
+	}
+	return list
+}
+
+func genLanguages(b *bytes.Buffer, list []iso639) {
+	list = append([]iso639(nil), list...)
+
+	writeList := func(name string, list []iso639, key func(iso639) string) {
+		sort.Slice(list, func(i, j int) bool { return key(list[i]) < key(list[j]) })
+		fmt.Fprintf(b, "var (\n\t%s []string = []string{\n", name)
+		for _, v := range list {
+			fmt.Fprintf(b, "\t\t%q, // %s\n", key(v), v.Name)
+		}
+		b.WriteString("\t}\n)\n\n")
+	}
+	writeMap := func(name string, list []iso639, key func(iso639) string) {
+		sort.Slice(list, func(i, j int) bool { return key(list[i]) < key(list[j]) })
+		fmt.Fprintf(b, "var %s map[string]string = map[string]string{\n", name)
+		for _, v := range list {
+			fmt.Fprintf(b, "\t%q: %q, // %s\n", key(v), v.Code(), v.Name)
+		}
+		b.WriteString("}\n\n")
+	}
+
+	var alpha2, bib []iso639
+	for _, v := range list {
+		if v.Alpha2 != "" {
+			alpha2 = append(alpha2, v)
+		}
+		if v.Term != "" {
+			bib = append(bib, v)
+		}
+	}
+
+	b.WriteString("// ISO 639-1:2002 Language codes\n")
+	b.WriteString("// https://en.wikipedia.org/wiki/ISO_639-1\n")
+	writeList("ISO_639_1_2002_CODES", alpha2, func(l iso639) string { return l.Alpha2 })
+
+	b.WriteString("// ISO 639-2/B-1998 Language codes\n")
+	b.WriteString("// https://www.loc.gov/standards/iso639-2/php/code_list.php\n")
+	b.WriteString("// https://en.wikipedia.org/wiki/ISO_639-2\n")
+	writeList("ISO_639_2B_1998_CODES", bib, func(l iso639) string { return l.Bib })
+
+	b.WriteString("// ISO 639-2/T-1998 Language codes\n")
+	b.WriteString("// https://www.loc.gov/standards/iso639-2/php/code_list.php\n")
+	b.WriteString("// https://en.wikipedia.org/wiki/ISO_639-2\n")
+	writeList("ISO_639_2T_1998_CODES", list, iso639.Code)
+
+	writeMap("ISO_639_2B_TO_2T_MAP", bib, func(l iso639) string { return l.Bib })
+	writeMap("ISO_639_1_TO_2T_MAP", alpha2, func(l iso639) string { return l.Alpha2 })
+}
+
+type dataset struct {
+	name, file, published string
+}
+
+// seed marks snapshots converted from the formerly hand-maintained tables
+// which have no upstream version. Replace it when refreshing a snapshot.
+const seed = "seed"
+
+// Upstream releases of verbatim snapshots.
+const (
+	isoCodes  = "iso-codes 4.15.0"
+	gountries = "gountries v0.1.6"
+)
+
+func genDatasets(b *bytes.Buffer, list []dataset) {
+	b.WriteString("// Upstream snapshots the tables were generated from, see Datasets.\n")
+	b.WriteString("var datasets = map[string]Dataset{\n")
+	for _, d := range list {
+		files, err := filepath.Glob(d.file)
+		if err != nil || len(files) == 0 {
+			log.Fatalf("%s: no such file", d.file)
+		}
+		sum, err := gen.Checksum(files...)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Fprintf(b, "\t%q: {%q, %q, %q},\n", d.name, d.file, d.published, sum)
+	}
+	b.WriteString("}\n")
+}
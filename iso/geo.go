@@ -0,0 +1,235 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// BoundingBox is a rectangle in WGS 84 coordinates. Boxes of countries
+// crossing the antimeridian like Russia or Fiji span all longitudes.
+type BoundingBox struct {
+	South float64
+	West  float64
+	North float64
+	East  float64
+}
+
+// Contains returns true when the point at lat, lon lies within the box.
+func (b BoundingBox) Contains(lat, lon float64) bool {
+	return lat >= b.South && lat <= b.North && lon >= b.West && lon <= b.East
+}
+
+func (b *BoundingBox) extend(lat, lon float64) {
+	if lat < b.South {
+		b.South = lat
+	}
+	if lat > b.North {
+		b.North = lat
+	}
+	if lon < b.West {
+		b.West = lon
+	}
+	if lon > b.East {
+		b.East = lon
+	}
+}
+
+// distance returns the distance of the point at lat, lon from the box in
+// degrees of latitude. Longitudes are scaled by scale, the cosine of lat.
+func (b BoundingBox) distance(lat, lon, scale float64) float64 {
+	dlat := math.Max(0, math.Max(b.South-lat, lat-b.North))
+	dlon := math.Max(0, math.Max(b.West-lon, lon-b.East)) * scale
+	return math.Hypot(dlat, dlon)
+}
+
+// area returns the area of the box in square degrees.
+func (b BoundingBox) area() float64 {
+	return (b.North - b.South) * (b.East - b.West)
+}
+
+// shapeBounds validates rings of alternating longitude and latitude values
+// and returns their bounding box.
+func shapeBounds(rings [][]float64) (BoundingBox, bool) {
+	bb := BoundingBox{South: 90, West: 180, North: -90, East: -180}
+	if len(rings) == 0 {
+		return bb, false
+	}
+	for _, ring := range rings {
+		if len(ring)%2 != 0 || len(ring) < 6 {
+			return bb, false
+		}
+		for j := 0; j < len(ring); j += 2 {
+			bb.extend(ring[j+1], ring[j])
+		}
+	}
+	return bb, true
+}
+
+// shapeCenter returns the centroid of the largest ring. For shapes made of
+// several parts like a mainland and islands the point lies on the largest
+// part.
+func shapeCenter(rings [][]float64) (float64, float64) {
+	var lat, lon, max float64
+	for _, ring := range rings {
+		var a, x, y float64
+		n := len(ring)
+		for i, j := 0, n-2; i < n; j, i = i, i+2 {
+			f := ring[j]*ring[i+1] - ring[i]*ring[j+1]
+			a += f
+			x += (ring[j] + ring[i]) * f
+			y += (ring[j+1] + ring[i+1]) * f
+		}
+		if math.Abs(a) > max {
+			max = math.Abs(a)
+			lon, lat = x/(3*a), y/(3*a)
+		}
+	}
+	return lat, lon
+}
+
+// containsPoint tests the point at lat, lon against rings of alternating
+// longitude and latitude values using the even-odd rule, so points inside
+// holes are outside the shape.
+func containsPoint(rings [][]float64, lat, lon float64) bool {
+	in := false
+	for _, ring := range rings {
+		n := len(ring)
+		for i, j := 0, n-2; i < n; j, i = i, i+2 {
+			xi, yi := ring[i], ring[i+1]
+			xj, yj := ring[j], ring[j+1]
+			if (yi > lat) != (yj > lat) && lon < (xj-xi)*(lat-yi)/(yj-yi)+xi {
+				in = !in
+			}
+		}
+	}
+	return in
+}
+
+// shapeDistance returns the distance of the point at lat, lon from the
+// nearest edge of rings in degrees of latitude. Longitudes are scaled by
+// scale, the cosine of lat.
+func shapeDistance(rings [][]float64, lat, lon, scale float64) float64 {
+	d := math.Inf(1)
+	for _, ring := range rings {
+		n := len(ring)
+		for i, j := 0, n-2; i < n; j, i = i, i+2 {
+			ax, ay := (ring[j]-lon)*scale, ring[j+1]-lat
+			dx, dy := (ring[i]-ring[j])*scale, ring[i+1]-ring[j+1]
+			var t float64
+			if l := dx*dx + dy*dy; l > 0 {
+				t = math.Max(0, math.Min(1, -(ax*dx+ay*dy)/l))
+			}
+			d = math.Min(d, math.Hypot(ax+t*dx, ay+t*dy))
+		}
+	}
+	return d
+}
+
+// CountryAt returns the country at the point lat, lon or CountryUndefined
+// when the point lies in international waters. Boundaries are simplified
+// to a 1:110m scale. Countries too small for this scale are matched by
+// their bounding box and points just off a simplified coast resolve to the
+// nearest country, so points close to a border may not be resolved
+// correctly.
+func CountryAt(lat, lon float64) Country {
+	return Default().CountryAt(lat, lon)
+}
+
+// RegionAt returns the most specific region at the point lat, lon or
+// RegionUndefined when the point lies outside all known regions. Only
+// bounding boxes and centroids of regions are embedded, so points near a
+// regional border may resolve to the neighbour. Load exact boundaries
+// with LoadRegionShapes.
+func RegionAt(lat, lon float64) Region {
+	return Default().RegionAt(lat, lon)
+}
+
+// Region property names of common GeoJSON boundary datasets, i.e. Natural
+// Earth admin 1 states and provinces and geoBoundaries ADM1.
+var regionCodeProperties = []string{"iso_3166_2", "ISO3166-2", "shapeISO", "code"}
+
+// LoadRegionShapes adds boundaries of ISO 3166-2 regions to the default
+// registry from a GeoJSON FeatureCollection of Polygon or MultiPolygon
+// features. The region code is read from the first of the properties
+// iso_3166_2, ISO3166-2, shapeISO or code. Features without a valid code
+// are skipped, features sharing a code are merged. Bounding boxes and
+// centroids are derived from the boundaries. Natural Earth's 1:10m admin 1
+// states and provinces are a suitable source:
+//
+//	https://www.naturalearthdata.com/downloads/10m-cultural-vectors/
+//
+// The boundaries are applied atomically, either all or none.
+func LoadRegionShapes(r io.Reader) error {
+	var fc struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Properties map[string]interface{} `json:"properties"`
+			Geometry   struct {
+				Type        string          `json:"type"`
+				Coordinates json.RawMessage `json:"coordinates"`
+			} `json:"geometry"`
+		} `json:"features"`
+	}
+	if err := json.NewDecoder(r).Decode(&fc); err != nil {
+		return fmt.Errorf("iso: reading region shapes: %v", err)
+	}
+	if fc.Type != "FeatureCollection" {
+		return fmt.Errorf("iso: reading region shapes: unsupported GeoJSON type '%s'", fc.Type)
+	}
+	shapes := make(map[Region][][]float64)
+	order := make([]Region, 0, len(fc.Features))
+	for i, f := range fc.Features {
+		var code string
+		for _, k := range regionCodeProperties {
+			if v, ok := f.Properties[k].(string); ok && v != "" {
+				code = strings.ToUpper(v)
+				break
+			}
+		}
+		ff := strings.SplitN(code, "-", 2)
+		if len(ff) != 2 || ff[1] == "" || !ParseCountry(ff[0]).IsValid() {
+			continue
+		}
+		var polygons [][][][2]float64
+		switch f.Geometry.Type {
+		case "Polygon":
+			polygons = make([][][][2]float64, 1)
+			if err := json.Unmarshal(f.Geometry.Coordinates, &polygons[0]); err != nil {
+				return fmt.Errorf("iso: region %s: %v", code, err)
+			}
+		case "MultiPolygon":
+			if err := json.Unmarshal(f.Geometry.Coordinates, &polygons); err != nil {
+				return fmt.Errorf("iso: region %s: %v", code, err)
+			}
+		default:
+			return fmt.Errorf("iso: feature %d: unsupported geometry '%s'", i, f.Geometry.Type)
+		}
+		reg := Region(code)
+		if _, ok := shapes[reg]; !ok {
+			order = append(order, reg)
+		}
+		for _, p := range polygons {
+			for _, ring := range p {
+				flat := make([]float64, 0, 2*len(ring))
+				for _, pt := range ring {
+					flat = append(flat, pt[0], pt[1])
+				}
+				shapes[reg] = append(shapes[reg], flat)
+			}
+		}
+	}
+	return updateDefault(func(b *Builder) error {
+		for _, reg := range order {
+			if err := b.SetRegionShape(reg, shapes[reg]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCountryAt(t *testing.T) {
+	tests := []struct {
+		lat, lon float64
+		want     Country
+	}{
+		{40.7, -74.0, "US"},      // New York, off the simplified coast
+		{1.35, 103.8, "SG"},      // Singapore, inside Malaysia's boundary
+		{3.14, 101.69, "MY"},     // Kuala Lumpur
+		{43.73, 7.42, "MC"},      // Monaco, inside France's boundary
+		{43.70, 7.27, "FR"},      // Nice
+		{41.9029, 12.4534, "VA"}, // St. Peter's
+		{41.896, 12.482, "IT"},   // Rome
+		{26.2, 50.58, "BH"},      // Manama
+		{26.4, 50.1, "SA"},       // Dammam
+		{52.52, 13.4, "DE"},
+		{0, -30, CountryUndefined},
+	}
+	for _, tt := range tests {
+		if got := CountryAt(tt.lat, tt.lon); got != tt.want {
+			t.Errorf("CountryAt(%v, %v) = %q, want %q", tt.lat, tt.lon, got, tt.want)
+		}
+	}
+	if !Country("MC").Contains(43.73, 7.42) {
+		t.Errorf("MC: Contains(43.73, 7.42) = false")
+	}
+	if _, ok := Country("SG").BoundingBox(); !ok {
+		t.Errorf("SG: no bounding box")
+	}
+}
+
+func TestRegionCoordinates(t *testing.T) {
+	lat, lon, ok := ParseRegion("DE-BY").GPS()
+	if !ok || lat < 47 || lat > 51 || lon < 9 || lon > 14 {
+		t.Errorf("DE-BY: GPS() = %v, %v, %v", lat, lon, ok)
+	}
+	bb, ok := ParseRegion("DE-BY").BoundingBox()
+	if !ok || !bb.Contains(lat, lon) {
+		t.Errorf("DE-BY: BoundingBox() = %v, %v", bb, ok)
+	}
+	if _, _, ok := Region("DE-XX").GPS(); ok {
+		t.Errorf("DE-XX: GPS() succeeded")
+	}
+}
+
+func TestRegionAt(t *testing.T) {
+	tests := []struct {
+		lat, lon float64
+		want     Region
+	}{
+		{48.1, 11.5, "DE-BY"},     // Munich
+		{52.52, 13.4, "DE-BE"},    // Berlin
+		{53.55, 10.0, "DE-HH"},    // Hamburg
+		{48.78, 9.18, "DE-BW"},    // Stuttgart
+		{34.05, -118.25, "US-CA"}, // Los Angeles
+		{35.68, 139.69, "JP-13"},  // Tokyo
+		{0, -160, RegionUndefined},
+	}
+	for _, tt := range tests {
+		if got := RegionAt(tt.lat, tt.lon); got != tt.want {
+			t.Errorf("RegionAt(%v, %v) = %q, want %q", tt.lat, tt.lon, got, tt.want)
+		}
+	}
+}
+
+func TestRegionAtPrefersShapes(t *testing.T) {
+	// a loaded boundary wins over bounding boxes of neighbouring regions
+	r, err := Default().Extend(func(b *Builder) error {
+		return b.SetRegionShape("DE-BW", [][]float64{{11, 48, 12, 48, 12, 48.5, 11, 48.5, 11, 48}})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.RegionAt(48.1, 11.5); got != "DE-BW" {
+		t.Errorf("RegionAt with shape = %q, want DE-BW", got)
+	}
+	if got := r.RegionAt(48.78, 9.18); !strings.HasPrefix(string(got), "DE-") || got == "DE-BW" {
+		t.Errorf("RegionAt outside shape = %q", got)
+	}
+}
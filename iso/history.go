@@ -0,0 +1,281 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Dataset identifies the version of an upstream data snapshot the tables
+// were generated from.
+type Dataset struct {
+	File      string // snapshot file, relative to the package directory
+	Published string // publication date or release, "seed" when unversioned
+	Checksum  string // truncated SHA-256 of the snapshot file
+}
+
+// Assignment records the period during which a code was assigned. A zero
+// From means the code was assigned in the first edition of the standard,
+// a zero To means the code is still assigned.
+type Assignment struct {
+	Code string
+	Name string
+	From time.Time
+	To   time.Time
+}
+
+// Covers returns true when the code was assigned at time t.
+func (a Assignment) Covers(t time.Time) bool {
+	return (a.From.IsZero() || !t.Before(a.From)) && (a.To.IsZero() || t.Before(a.To))
+}
+
+// FormerCountry is an ISO 3166-3 entry for a country name removed from
+// ISO 3166-1. Its alpha-2 code may have been reassigned since.
+type FormerCountry struct {
+	Code       string    // former alpha-2 code
+	Name       string    // former name
+	Withdrawn  time.Time // dates with year precision use January 1
+	Successors []string  // codes covering the former territory at withdrawal
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// Datasets returns the upstream snapshots the tables were generated from,
+// keyed by name.
+func Datasets() map[string]Dataset {
+	m := make(map[string]Dataset, len(datasets))
+	for k, v := range datasets {
+		m[k] = v
+	}
+	return m
+}
+
+// FormerCountries returns the ISO 3166-3 entries of the registry sorted by
+// code and withdrawal date.
+func (r *Registry) FormerCountries() []FormerCountry {
+	list := make([]FormerCountry, len(r.formerCountries))
+	for i, f := range r.formerCountries {
+		f.Successors = append([]string(nil), f.Successors...)
+		list[i] = f
+	}
+	return list
+}
+
+// CountryHistory returns the changes to the ISO 3166-1 code list.
+func (r *Registry) CountryHistory() []Assignment {
+	return append([]Assignment(nil), r.countryHistory...)
+}
+
+// CurrencyHistory returns the changes to the ISO 4217 code list.
+func (r *Registry) CurrencyHistory() []Assignment {
+	return append([]Assignment(nil), r.currencyHistory...)
+}
+
+// Snapshot is a view of the code lists as they were at a point in time.
+// Queries for dates after the embedded datasets were published return the
+// current lists.
+type Snapshot struct {
+	at time.Time
+	r  *Registry
+}
+
+// AsOf returns a view of the default registry's code lists as they were
+// at time t.
+func AsOf(t time.Time) Snapshot {
+	return Default().AsOf(t)
+}
+
+// AsOf returns a view of the registry's code lists as they were at time t.
+func (r *Registry) AsOf(t time.Time) Snapshot {
+	return Snapshot{at: t, r: r}
+}
+
+// Time returns the point in time the snapshot represents.
+func (s Snapshot) Time() time.Time {
+	return s.at
+}
+
+// ParseCountry works like the package level ParseCountry, but accepts
+// only codes that were assigned at the snapshot's time.
+func (s Snapshot) ParseCountry(c string) Country {
+	c = strings.ToUpper(c)
+	if isAssigned(c, s.at, s.r.countryHistory, s.r.countryCodes) {
+		return Country(c)
+	}
+	return CountryUndefined
+}
+
+// ParseCurrency works like the package level ParseCurrency, but accepts
+// only codes that were assigned at the snapshot's time.
+func (s Snapshot) ParseCurrency(c string) Currency {
+	c = strings.ToUpper(c)
+	if isAssigned(c, s.at, s.r.currencyHistory, s.r.currencyCodes) {
+		return Currency(c)
+	}
+	return CurrencyUndefined
+}
+
+// CountryName returns the name of country code c as it was assigned at
+// the snapshot's time.
+func (s Snapshot) CountryName(c Country) (string, bool) {
+	if n, ok := assignedName(string(c), s.at, s.r.countryHistory); ok {
+		return n, true
+	}
+	if isAssigned(string(c), s.at, s.r.countryHistory, s.r.countryCodes) {
+		return s.r.CountryName(c)
+	}
+	return "", false
+}
+
+// CurrencyName returns the name of currency code c as it was assigned at
+// the snapshot's time.
+func (s Snapshot) CurrencyName(c Currency) (string, bool) {
+	if n, ok := assignedName(string(c), s.at, s.r.currencyHistory); ok {
+		return n, true
+	}
+	if isAssigned(string(c), s.at, s.r.currencyHistory, s.r.currencyCodes) {
+		if cc, ok := s.r.currency(c); ok {
+			return cc.Name, true
+		}
+	}
+	return "", false
+}
+
+// CountryCodes returns the sorted list of country codes assigned at the
+// snapshot's time.
+func (s Snapshot) CountryCodes() []string {
+	return assignedCodes(s.at, s.r.countryHistory, s.r.countryCodes)
+}
+
+// CurrencyCodes returns the sorted list of currency codes assigned at the
+// snapshot's time.
+func (s Snapshot) CurrencyCodes() []string {
+	return assignedCodes(s.at, s.r.currencyHistory, s.r.currencyCodes)
+}
+
+// isAssigned returns true when code c was assigned at time t. Codes
+// without history entries and codes whose assignment has not ended
+// are checked against the current list.
+func isAssigned(c string, t time.Time, history []Assignment, current []string) bool {
+	found, open := false, false
+	for _, a := range history {
+		if a.Code != c {
+			continue
+		}
+		found = true
+		if a.Covers(t) {
+			if !a.To.IsZero() {
+				return true
+			}
+			open = true
+		}
+	}
+	if found && !open {
+		return false
+	}
+	for _, x := range current {
+		if x == c {
+			return true
+		}
+	}
+	return false
+}
+
+func assignedCodes(t time.Time, history []Assignment, current []string) []string {
+	seen := make(map[string]bool)
+	list := make([]string, 0, len(current))
+	for _, c := range current {
+		if !seen[c] && isAssigned(c, t, history, current) {
+			list = append(list, c)
+		}
+		seen[c] = true
+	}
+	for _, a := range history {
+		if !seen[a.Code] && isAssigned(a.Code, t, history, current) {
+			list = append(list, a.Code)
+		}
+		seen[a.Code] = true
+	}
+	sort.Strings(list)
+	return list
+}
+
+// formerCountry returns the ISO 3166-3 entry for alpha-2 code c withdrawn
+// first after time t. A zero t returns the latest entry.
+func (r *Registry) formerCountry(c string, t time.Time) (FormerCountry, bool) {
+	var (
+		best  FormerCountry
+		found bool
+	)
+	for _, f := range r.formerCountries {
+		if f.Code != c || (!t.IsZero() && !f.Withdrawn.After(t)) {
+			continue
+		}
+		if !found || (t.IsZero() && f.Withdrawn.After(best.Withdrawn)) ||
+			(!t.IsZero() && f.Withdrawn.Before(best.Withdrawn)) {
+			best, found = f, true
+		}
+	}
+	return best, found
+}
+
+// successors resolves the successors of a former country to codes which
+// are currently assigned or were not used again after the withdrawal.
+func (r *Registry) successors(f FormerCountry) []Country {
+	list := make([]Country, 0, len(f.Successors))
+	for _, s := range f.Successors {
+		if _, ok := r.countryNames[s]; !ok {
+			if next, ok := r.formerCountry(s, f.Withdrawn); ok {
+				list = append(list, r.successors(next)...)
+				continue
+			}
+		}
+		list = append(list, Country(s))
+	}
+	return list
+}
+
+// CountrySuccessors returns the current codes for country code c. Codes
+// in the country list return themselves, formerly used codes return the
+// countries that succeeded their most recent use.
+func (r *Registry) CountrySuccessors(c Country) CountrySet {
+	if _, ok := r.countryNames[string(c)]; ok {
+		return NewCountrySet(c)
+	}
+	if f, ok := r.formerCountry(string(c), time.Time{}); ok {
+		return NewCountrySet(r.successors(f)...)
+	}
+	return NewCountrySet()
+}
+
+// Successors returns the current codes to use in place of country code
+// c, e.g. CW, SX and BQ for the Netherlands Antilles.
+func (c Country) Successors() CountrySet {
+	return Default().CountrySuccessors(c)
+}
+
+// historicName returns the name of a code that is no longer assigned.
+func historicName(c string, history []Assignment) (string, bool) {
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Code == c {
+			return history[i].Name, true
+		}
+	}
+	return "", false
+}
+
+// assignedName returns the name of a code from the history entry covering
+// time t.
+func assignedName(c string, t time.Time, history []Assignment) (string, bool) {
+	for _, a := range history {
+		if a.Code == c && a.Covers(t) {
+			return a.Name, true
+		}
+	}
+	return "", false
+}
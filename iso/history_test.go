@@ -0,0 +1,120 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHistoryAccessorsCopy(t *testing.T) {
+	r := Default()
+	for _, f := range r.FormerCountries() {
+		if len(f.Successors) > 0 {
+			f.Successors[0] = "XX"
+		}
+	}
+	if h := r.CountryHistory(); len(h) > 0 {
+		h[0].Name = "changed"
+	}
+	if h := r.CurrencyHistory(); len(h) > 0 {
+		h[0].Name = "changed"
+	}
+	Datasets()["changed"] = Dataset{}
+
+	for _, f := range r.FormerCountries() {
+		for _, s := range f.Successors {
+			if s == "XX" {
+				t.Errorf("%s: successors modified through a returned slice", f.Code)
+			}
+		}
+	}
+	if h := r.CountryHistory(); len(h) > 0 && h[0].Name == "changed" {
+		t.Errorf("country history modified through a returned slice")
+	}
+	if h := r.CurrencyHistory(); len(h) > 0 && h[0].Name == "changed" {
+		t.Errorf("currency history modified through a returned slice")
+	}
+	if _, ok := Datasets()["changed"]; ok {
+		t.Errorf("datasets modified through a returned map")
+	}
+}
+
+func TestAsOfCountries(t *testing.T) {
+	tests := []struct {
+		year int
+		code string
+		want bool
+	}{
+		{2005, "SX", false},
+		{2011, "SX", true},
+		{1985, "HR", false},
+		{1985, "SI", false},
+		{1985, "BA", false},
+		{1985, "MK", false},
+		{1985, "ER", false},
+		{1985, "PS", false},
+		{1985, "DE", true},
+		{1985, "DD", true},
+		{1995, "DD", false},
+		{1980, "AI", false},
+		{1990, "AI", true},
+		{2024, "AI", true},
+		{2024, "CS", false},
+	}
+	for _, tt := range tests {
+		got := AsOf(date(tt.year, 1, 1)).ParseCountry(tt.code) != CountryUndefined
+		if got != tt.want {
+			t.Errorf("AsOf(%d).ParseCountry(%q) valid = %t, want %t", tt.year, tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestAsOfNames(t *testing.T) {
+	if n, _ := AsOf(date(1985, 1, 1)).CountryName("CS"); !strings.HasPrefix(n, "Czechoslovakia") {
+		t.Errorf("CS in 1985: %q", n)
+	}
+	if n, _ := AsOf(date(2005, 1, 1)).CountryName("CS"); n != "Serbia and Montenegro" {
+		t.Errorf("CS in 2005: %q", n)
+	}
+	if n, ok := AsOf(date(2000, 1, 1)).CountryName("CS"); ok {
+		t.Errorf("CS in 2000: %q", n)
+	}
+	if n, _ := AsOf(time.Now()).CountryName("DE"); n != Country("DE").String() {
+		t.Errorf("DE: %q", n)
+	}
+	if n, _ := AsOf(date(1980, 1, 1)).CurrencyName("DEM"); n != "Deutsche Mark" {
+		t.Errorf("DEM in 1980: %q", n)
+	}
+	if n, ok := AsOf(date(2010, 1, 1)).CurrencyName("DEM"); ok {
+		t.Errorf("DEM in 2010: %q", n)
+	}
+}
+
+func TestAsOfCurrencies(t *testing.T) {
+	tests := []struct {
+		year int
+		code string
+		want bool
+	}{
+		{2024, "HRK", false},
+		{2010, "HRK", true},
+		{1990, "HRK", false},
+		{2024, "BYR", false},
+		{2010, "BYR", true},
+		{2010, "BYN", false},
+		{2020, "BYN", true},
+		{1998, "EUR", false},
+		{2000, "DEM", true},
+		{2003, "DEM", false},
+		{2024, "USD", true},
+	}
+	for _, tt := range tests {
+		got := AsOf(date(tt.year, 1, 1)).ParseCurrency(tt.code) != CurrencyUndefined
+		if got != tt.want {
+			t.Errorf("AsOf(%d).ParseCurrency(%q) valid = %t, want %t", tt.year, tt.code, got, tt.want)
+		}
+	}
+}
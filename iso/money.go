@@ -0,0 +1,290 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Money is an amount in minor units of a currency. The amount is never
+// modified in place, so copies of a Money value may share it.
+//
+// Money encodes as JSON object with a decimal string amount, e.g.
+// {"amount":"12.34","currency":"EUR"}. Use MoneyText for strings like
+// "EUR 12.34" and MoneyMinor for objects with minor-unit integer amounts,
+// e.g. {"amount":1234,"currency":"EUR"}. The three types convert into each
+// other, so the encoding can be selected per struct field. Decoding
+// rejects unknown currencies and amounts with more fractional digits than
+// the currency's minor unit. The zero Money without currency encodes as
+// JSON null and SQL NULL and decodes from both.
+type Money struct {
+	Currency Currency
+	units    *big.Int
+}
+
+// MoneyText is Money encoded as string like "EUR 12.34".
+type MoneyText Money
+
+// MoneyMinor is Money encoded with an amount in minor units. In SQL it is
+// stored as currency code and minor units, e.g. "EUR 1234".
+type MoneyMinor Money
+
+// NewMoney returns n minor units of currency c.
+func NewMoney(n int64, c Currency) Money {
+	return Money{Currency: c, units: big.NewInt(n)}
+}
+
+// NewMoneyBig returns n minor units of currency c for amounts exceeding
+// int64, e.g. in currencies with 18 decimals.
+func NewMoneyBig(n *big.Int, c Currency) Money {
+	return Money{Currency: c, units: new(big.Int).Set(n)}
+}
+
+// ParseMoney parses a currency code and a decimal amount separated by
+// space in either order, e.g. "EUR 12.34" or "12.34 EUR".
+func ParseMoney(s string) (Money, error) {
+	f := strings.Fields(s)
+	if len(f) != 2 {
+		return Money{}, fmt.Errorf("iso: invalid money amount '%s'", s)
+	}
+	code, amount := f[0], f[1]
+	if c := ParseCurrency(code); !c.IsValid() {
+		code, amount = amount, code
+	}
+	return parseMoney(code, amount)
+}
+
+func parseMoney(code, amount string) (Money, error) {
+	c := ParseCurrency(code)
+	if !c.IsValid() {
+		return Money{}, fmt.Errorf("iso: invalid currency code '%s'", code)
+	}
+	n, err := c.ParseMinorUnits(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Currency: c, units: n}, nil
+}
+
+func (m Money) IsValid() bool {
+	return m.Currency.IsValid()
+}
+
+// IsZero returns true when the amount is zero.
+func (m Money) IsZero() bool {
+	return m.units == nil || m.units.Sign() == 0
+}
+
+// MinorUnits returns a copy of the amount in minor units.
+func (m Money) MinorUnits() *big.Int {
+	if m.units == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(m.units)
+}
+
+// Amount returns the amount as decimal string with all digits of the
+// minor unit, e.g. 12.30.
+func (m Money) Amount() string {
+	return m.Currency.FormatMinorUnits(m.MinorUnits())
+}
+
+// Float64 returns the nearest float64 to the amount.
+func (m Money) Float64() float64 {
+	r := new(big.Rat).SetFrac(m.MinorUnits(), m.Currency.SubUnitToUnit())
+	f, _ := r.Float64()
+	return f
+}
+
+// Format returns the amount formatted according to currency rules and
+// options, see Currency.Format. Standard and accounting styles format the
+// minor units exactly, so no digits are lost in currencies with 18 or more
+// decimals.
+func (m Money) Format(opts *CurrencyOptions) string {
+	if opts != nil && (opts.FormatStyle == CurrencyStyleCompact || opts.FormatStyle == CurrencyStyleSpelled) {
+		return m.Currency.Format(m.Float64(), opts)
+	}
+	return m.Currency.formatUnits(m.MinorUnits(), opts)
+}
+
+func (m Money) String() string {
+	return string(m.Currency) + " " + m.Amount()
+}
+
+// Text/JSON conversion
+type moneyJSON struct {
+	Amount   json.RawMessage `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	if !m.IsValid() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(struct {
+		Amount   string   `json:"amount"`
+		Currency Currency `json:"currency"`
+	}{m.Amount(), m.Currency})
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*m = Money{}
+		return nil
+	}
+	var v moneyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("iso: invalid money amount: %v", err)
+	}
+	// accept numbers as well as strings, precision is checked anyway
+	amount := string(bytes.Trim(v.Amount, `"`))
+	mm, err := parseMoney(v.Currency, amount)
+	if err != nil {
+		return err
+	}
+	*m = mm
+	return nil
+}
+
+func (m MoneyText) MarshalText() ([]byte, error) {
+	if !Money(m).IsValid() {
+		return []byte{}, nil
+	}
+	return []byte(Money(m).String()), nil
+}
+
+func (m *MoneyText) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*m = MoneyText{}
+		return nil
+	}
+	mm, err := ParseMoney(string(data))
+	if err != nil {
+		return err
+	}
+	*m = MoneyText(mm)
+	return nil
+}
+
+func (m MoneyText) String() string {
+	return Money(m).String()
+}
+
+func (m MoneyMinor) MarshalJSON() ([]byte, error) {
+	if !Money(m).IsValid() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(struct {
+		Amount   json.Number `json:"amount"`
+		Currency Currency    `json:"currency"`
+	}{json.Number(Money(m).MinorUnits().String()), m.Currency})
+}
+
+func (m *MoneyMinor) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*m = MoneyMinor{}
+		return nil
+	}
+	var v moneyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("iso: invalid money amount: %v", err)
+	}
+	// accept quoted integers as well, e.g. for amounts exceeding 2^53
+	mm, err := parseMoneyMinor(v.Currency, string(bytes.Trim(v.Amount, `"`)))
+	if err != nil {
+		return err
+	}
+	*m = mm
+	return nil
+}
+
+func parseMoneyMinor(code, amount string) (MoneyMinor, error) {
+	c := ParseCurrency(code)
+	if !c.IsValid() {
+		return MoneyMinor{}, fmt.Errorf("iso: invalid currency code '%s'", code)
+	}
+	n, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return MoneyMinor{}, fmt.Errorf("iso: invalid %s minor unit amount '%s'", string(c), amount)
+	}
+	return MoneyMinor{Currency: c, units: n}, nil
+}
+
+func (m MoneyMinor) String() string {
+	return Money(m).String()
+}
+
+// SQL conversion
+func (m *Money) Scan(value interface{}) error {
+	var (
+		mm  Money
+		err error
+	)
+	switch v := value.(type) {
+	case nil:
+	case string:
+		mm, err = ParseMoney(v)
+	case []byte:
+		mm, err = ParseMoney(string(v))
+	default:
+		err = fmt.Errorf("iso: invalid money amount '%v'", value)
+	}
+	if err != nil {
+		return err
+	}
+	*m = mm
+	return nil
+}
+
+func (m Money) Value() (driver.Value, error) {
+	if !m.IsValid() {
+		return nil, nil
+	}
+	return m.String(), nil
+}
+
+func (m *MoneyText) Scan(value interface{}) error {
+	return (*Money)(m).Scan(value)
+}
+
+func (m MoneyText) Value() (driver.Value, error) {
+	return Money(m).Value()
+}
+
+func (m *MoneyMinor) Scan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		*m = MoneyMinor{}
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("iso: invalid money amount '%v'", value)
+	}
+	f := strings.Fields(s)
+	if len(f) != 2 {
+		return fmt.Errorf("iso: invalid money amount '%s'", s)
+	}
+	mm, err := parseMoneyMinor(f[0], f[1])
+	if err != nil {
+		return err
+	}
+	*m = mm
+	return nil
+}
+
+func (m MoneyMinor) Value() (driver.Value, error) {
+	if !Money(m).IsValid() {
+		return nil, nil
+	}
+	return string(m.Currency) + " " + Money(m).MinorUnits().String(), nil
+}
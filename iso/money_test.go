@@ -0,0 +1,102 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMoneyJSON(t *testing.T) {
+	type record struct {
+		Price Money      `json:"price"`
+		Text  MoneyText  `json:"text"`
+		Minor MoneyMinor `json:"minor"`
+	}
+	tests := []struct {
+		in   record
+		want string
+	}{
+		{record{}, `{"price":null,"text":"","minor":null}`},
+		{
+			record{NewMoney(1234, "EUR"), MoneyText(NewMoney(-5, "USD")), MoneyMinor(NewMoney(1234, "JPY"))},
+			`{"price":{"amount":"12.34","currency":"EUR"},"text":"USD -0.05","minor":{"amount":1234,"currency":"JPY"}}`,
+		},
+	}
+	for _, tt := range tests {
+		buf, err := json.Marshal(tt.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(buf) != tt.want {
+			t.Errorf("Marshal = %s, want %s", buf, tt.want)
+		}
+		var out record
+		if err := json.Unmarshal(buf, &out); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", buf, err)
+		}
+		if out.Price.String() != tt.in.Price.String() || out.Text.String() != tt.in.Text.String() || out.Minor.String() != tt.in.Minor.String() {
+			t.Errorf("round trip of %s = %+v", buf, out)
+		}
+	}
+}
+
+func TestMoneyMinorJSON(t *testing.T) {
+	for _, in := range []string{
+		`{"amount":1234,"currency":"EUR"}`,
+		`{"amount":"1234","currency":"EUR"}`,
+	} {
+		var m MoneyMinor
+		if err := json.Unmarshal([]byte(in), &m); err != nil {
+			t.Errorf("Unmarshal(%s): %v", in, err)
+			continue
+		}
+		if got, want := m.String(), "EUR 12.34"; got != want {
+			t.Errorf("Unmarshal(%s) = %s, want %s", in, got, want)
+		}
+	}
+	for _, in := range []string{
+		`{"amount":"12.34","currency":"EUR"}`,
+		`{"amount":1234,"currency":"XYZ"}`,
+	} {
+		var m MoneyMinor
+		if err := json.Unmarshal([]byte(in), &m); err == nil {
+			t.Errorf("Unmarshal(%s) = %s, want error", in, m)
+		}
+	}
+}
+
+func TestMoneySQL(t *testing.T) {
+	m := NewMoney(1234, "EUR")
+	if v, _ := m.Value(); v != "EUR 12.34" {
+		t.Errorf("Money.Value = %v", v)
+	}
+	if v, _ := MoneyText(m).Value(); v != "EUR 12.34" {
+		t.Errorf("MoneyText.Value = %v", v)
+	}
+	if v, _ := MoneyMinor(m).Value(); v != "EUR 1234" {
+		t.Errorf("MoneyMinor.Value = %v", v)
+	}
+	if v, _ := (Money{}).Value(); v != nil {
+		t.Errorf("zero Money.Value = %v, want nil", v)
+	}
+
+	var (
+		mm Money
+		mt MoneyText
+		mi MoneyMinor
+	)
+	if err := mm.Scan("EUR 12.34"); err != nil || mm.String() != "EUR 12.34" {
+		t.Errorf("Money.Scan = %s, %v", mm, err)
+	}
+	if err := mt.Scan([]byte("12.34 EUR")); err != nil || mt.String() != "EUR 12.34" {
+		t.Errorf("MoneyText.Scan = %s, %v", mt, err)
+	}
+	if err := mi.Scan("EUR 1234"); err != nil || mi.String() != "EUR 12.34" {
+		t.Errorf("MoneyMinor.Scan = %s, %v", mi, err)
+	}
+	if err := mi.Scan(nil); err != nil || Money(mi).IsValid() {
+		t.Errorf("MoneyMinor.Scan(nil) = %s, %v", mi, err)
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type currencyOverlay struct {
+	code    string
+	retired bool
+	apply   func(*currency) error
+}
+
+// LoadCurrencies adds, overrides or retires entries in the currency tables
+// of the default registry. Input is either JSON or CSV, detected from the
+// first non-space character.
+//
+// JSON input is an array of objects or an object keyed by currency code, so
+// RubyMoney's currency_iso.json can be loaded as is. Field names follow that
+// file (iso_code, iso_numeric, name, symbol, symbol_first, alternate_symbols,
+// thousands_separator, decimal_mark, subunit, subunit_to_unit, html_entity)
+// with the additions subunit_precision, cash_precision, cash_rounding,
+// category, custom and retired. Custom currencies may use codes of 2 to 12
+// letters and digits, see RegisterCurrency.
+//
+// CSV input must have a header row using the same field names. Multiple
+// alternate symbols are separated by '|'. Empty cells keep the current value.
+//
+// Fields missing from a record keep their current value when the currency
+// exists. Retired currencies are reported as historic but can still be
+// parsed and formatted. The overlay is applied atomically, either all
+// records are applied or none.
+func LoadCurrencies(r io.Reader) error {
+	br := bufio.NewReader(r)
+	var (
+		list []currencyOverlay
+		err  error
+	)
+	switch peek(br) {
+	case '[', '{':
+		list, err = readCurrencyJSON(br)
+	default:
+		list, err = readCurrencyCSV(br)
+	}
+	if err != nil {
+		return err
+	}
+
+	return updateDefault(func(b *Builder) error {
+		for _, v := range list {
+			if v.retired {
+				b.RemoveCurrency(Currency(v.code))
+				continue
+			}
+			cc, ok := b.r.currencies[v.code]
+			if !ok {
+				cc = newCurrency(v.code)
+				cc.CashPrecision = -1
+			}
+			if err := v.apply(&cc); err != nil {
+				return fmt.Errorf("iso: currency %s: %v", v.code, err)
+			}
+			if err := cc.validate(); err != nil {
+				return fmt.Errorf("iso: currency %s: %v", v.code, err)
+			}
+			// cash precision follows the electronic precision unless set
+			if cc.CashPrecision < 0 || cc.CashPrecision > cc.SubUnitPrecision {
+				cc.CashPrecision = cc.SubUnitPrecision
+			}
+			b.setCurrency(v.code, cc)
+		}
+		return nil
+	})
+}
+
+// peek returns the first non-space byte without consuming it.
+func peek(r *bufio.Reader) byte {
+	for {
+		b, err := r.Peek(1)
+		if err != nil {
+			return 0
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			r.ReadByte()
+		default:
+			return b[0]
+		}
+	}
+}
+
+func parseCurrencyCode(s string) (string, error) {
+	c := strings.ToUpper(strings.TrimSpace(s))
+	if len(c) != 3 {
+		return "", fmt.Errorf("iso: invalid currency code '%s'", s)
+	}
+	for _, r := range c {
+		if r < 'A' || r > 'Z' {
+			return "", fmt.Errorf("iso: invalid currency code '%s'", s)
+		}
+	}
+	return c, nil
+}
+
+// parseCustomCurrencyCode accepts codes of 2 to 12 upper case letters and
+// digits starting with a letter, e.g. BTC, USDT or PTS.
+func parseCustomCurrencyCode(s string) (string, error) {
+	c := strings.ToUpper(strings.TrimSpace(s))
+	if len(c) < 2 || len(c) > 12 || c[0] < 'A' || c[0] > 'Z' {
+		return "", fmt.Errorf("iso: invalid custom currency code '%s'", s)
+	}
+	for _, r := range c {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return "", fmt.Errorf("iso: invalid custom currency code '%s'", s)
+		}
+	}
+	return c, nil
+}
+
+func readCurrencyJSON(r io.Reader) ([]currencyOverlay, error) {
+	buf, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		raw  []json.RawMessage
+		keys []string
+	)
+	if buf = bytes.TrimSpace(buf); len(buf) > 0 && buf[0] == '{' {
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(buf, &m); err != nil {
+			return nil, fmt.Errorf("iso: reading currencies: %v", err)
+		}
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			raw = append(raw, m[k])
+		}
+	} else if err := json.Unmarshal(buf, &raw); err != nil {
+		return nil, fmt.Errorf("iso: reading currencies: %v", err)
+	}
+	list := make([]currencyOverlay, 0, len(raw))
+	for i, msg := range raw {
+		var head struct {
+			Code    string          `json:"iso_code"`
+			Numeric json.RawMessage `json:"iso_numeric"`
+			Retired bool            `json:"retired"`
+			Custom  bool            `json:"custom"`
+			Cash    *int            `json:"cash_precision"`
+		}
+		if err := json.Unmarshal(msg, &head); err != nil {
+			return nil, fmt.Errorf("iso: reading currencies: %v", err)
+		}
+		if head.Code == "" && keys != nil {
+			head.Code = keys[i]
+		}
+		parse := parseCurrencyCode
+		if head.Custom {
+			parse = parseCustomCurrencyCode
+		}
+		code, err := parse(head.Code)
+		if err != nil {
+			return nil, err
+		}
+		if head.Cash != nil && *head.Cash < 0 {
+			return nil, fmt.Errorf("iso: currency %s: invalid cash_precision %d", code, *head.Cash)
+		}
+		msg := msg
+		list = append(list, currencyOverlay{
+			code:    code,
+			retired: head.Retired,
+			apply: func(cc *currency) error {
+				if n := strings.Trim(string(head.Numeric), `"`); n != "" && n != "null" {
+					num, err := strconv.Atoi(n)
+					if err != nil {
+						return fmt.Errorf("invalid iso_numeric %s", n)
+					}
+					cc.IsoNumeric = num
+				}
+				return json.Unmarshal(msg, cc)
+			},
+		})
+	}
+	return list, nil
+}
+
+func readCurrencyCSV(r io.Reader) ([]currencyOverlay, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	recs, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("iso: reading currencies: %v", err)
+	}
+	if len(recs) == 0 {
+		return nil, nil
+	}
+	header := recs[0]
+	list := make([]currencyOverlay, 0, len(recs)-1)
+	for _, rec := range recs[1:] {
+		row := make(map[string]string, len(header))
+		for i, h := range header {
+			if i < len(rec) && rec[i] != "" {
+				row[strings.TrimSpace(h)] = rec[i]
+			}
+		}
+		parse := parseCurrencyCode
+		if custom, _ := strconv.ParseBool(row["custom"]); custom {
+			parse = parseCustomCurrencyCode
+		}
+		code, err := parse(row["iso_code"])
+		if err != nil {
+			return nil, err
+		}
+		retired, _ := strconv.ParseBool(row["retired"])
+		list = append(list, currencyOverlay{
+			code:    code,
+			retired: retired,
+			apply: func(cc *currency) error {
+				return cc.setFields(row)
+			},
+		})
+	}
+	return list, nil
+}
+
+// validate checks fields of an overlay record.
+func (c currency) validate() error {
+	switch c.Category {
+	case CurrencyCategoryLegalTender, CurrencyCategoryFund, CurrencyCategoryMetal,
+		CurrencyCategoryUnit, CurrencyCategoryTest, CurrencyCategoryNone,
+		CurrencyCategoryCustom:
+	default:
+		return fmt.Errorf("invalid category '%s'", string(c.Category))
+	}
+	if c.SubUnitPrecision < 0 {
+		return fmt.Errorf("invalid subunit_precision %d", c.SubUnitPrecision)
+	}
+	if c.CashRounding < 0 {
+		return fmt.Errorf("invalid cash_rounding %d", c.CashRounding)
+	}
+	return nil
+}
+
+// setFields sets currency fields from a CSV row keyed by JSON field name.
+func (c *currency) setFields(row map[string]string) error {
+	var err error
+	for k, v := range row {
+		switch k {
+		case "iso_numeric":
+			c.IsoNumeric, err = strconv.Atoi(v)
+		case "name":
+			c.Name = v
+		case "symbol":
+			c.Symbol = v
+		case "symbol_first":
+			c.SymbolFirst, err = strconv.ParseBool(v)
+		case "alternate_symbols":
+			c.AlternateSymbols = strings.Split(v, "|")
+		case "thousands_separator":
+			c.ThousandsSeparator = v
+		case "decimal_mark":
+			c.DecimalMark = v
+		case "subunit":
+			c.SubUnit = v
+		case "subunit_to_unit":
+			c.SubUnitToUnit, err = strconv.ParseInt(v, 10, 64)
+		case "subunit_precision":
+			c.SubUnitPrecision, err = strconv.Atoi(v)
+		case "cash_precision":
+			if c.CashPrecision, err = strconv.Atoi(v); err == nil && c.CashPrecision < 0 {
+				err = fmt.Errorf("negative precision")
+			}
+		case "cash_rounding":
+			c.CashRounding, err = strconv.Atoi(v)
+		case "html_entity":
+			c.HTMLEntity = v
+		case "custom":
+			c.Custom, err = strconv.ParseBool(v)
+		case "category":
+			c.Category = CurrencyCategory(v)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid %s '%s'", k, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadCurrenciesRejectsInvalidFields(t *testing.T) {
+	for _, in := range []string{
+		`[{"iso_code":"XTS","category":"crypto"}]`,
+		`[{"iso_code":"XTS","subunit_precision":-1}]`,
+		`[{"iso_code":"XTS","cash_precision":-2}]`,
+		"iso_code,category\nXTS,crypto\n",
+		"iso_code,subunit_precision\nXTS,-1\n",
+		"iso_code,cash_precision\nXTS,-2\n",
+	} {
+		if err := LoadCurrencies(strings.NewReader(in)); err == nil {
+			t.Errorf("LoadCurrencies(%q) succeeded, want error", in)
+		}
+	}
+	if got := Currency("XTS").Category(); got != CurrencyCategoryTest {
+		t.Errorf("XTS category %q after failed overlays, want %q", got, CurrencyCategoryTest)
+	}
+}
+
+func TestLoadCurrenciesCashPrecision(t *testing.T) {
+	old := Default()
+	defer SetDefault(old)
+
+	in := "iso_code,subunit_precision,cash_precision,cash_rounding\n" +
+		"ZZA,2,2,5\n" +
+		"ZZB,2,,\n" +
+		"ZZC,2,4,\n" +
+		"JPY,0,,\n"
+	if err := LoadCurrencies(strings.NewReader(in)); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		code      Currency
+		digits    int
+		increment int
+	}{
+		{"ZZA", 2, 5},
+		{"ZZB", 2, 1}, // follows the electronic precision
+		{"ZZC", 2, 1}, // capped at the electronic precision
+		{"JPY", 0, 1},
+	}
+	for _, tt := range tests {
+		digits, increment := tt.code.Rounding(PrecisionCash)
+		if digits != tt.digits || increment != tt.increment {
+			t.Errorf("%s: Rounding(PrecisionCash) = %d, %d, want %d, %d", tt.code, digits, increment, tt.digits, tt.increment)
+		}
+	}
+	if got := Currency("ZZA").RoundTo(1.23, PrecisionCash); got != 1.25 {
+		t.Errorf("ZZA: RoundTo(1.23, PrecisionCash) = %v, want 1.25", got)
+	}
+}
@@ -12,14 +12,26 @@ import (
 // ISO 3166-2 Country subdivision code
 type Region string
 
+// RegionInfo holds ISO 3166-2 subdivision data.
+type RegionInfo struct {
+	Name   string // ISO 3166-2 name, romanised for non-Latin scripts
+	Type   string // subdivision category, e.g. "State" or "Prefecture"
+	Parent Region // enclosing subdivision, may be empty
+}
+
 const (
 	RegionUndefined Region = ""
 )
 
+// ParseRegion returns the region for an ISO 3166-2 code. Withdrawn codes
+// with a known replacement like CN-21 or MX-DIF return the current code.
 func ParseRegion(c string) Region {
 	if c == "" {
 		return RegionUndefined
 	}
+	if r, ok := region_aliases[strings.ToUpper(c)]; ok {
+		return r
+	}
 	ff := strings.SplitN(c, "-", 2)
 	if len(ff) != 2 {
 		return RegionUndefined
@@ -66,14 +78,71 @@ func (r Region) Value() (driver.Value, error) {
 	return string(r), nil
 }
 
+// String returns the region's English name. When CLDR has no English
+// name, the first ISO 3166-2 name is returned.
 func (c Region) String() string {
-	// TODO
-	// if n, ok := region_names[string(c)]; ok {
-	// 	return n
-	// }
+	if n, ok := Default().RegionNameIn(c, LanguageEnglish); ok {
+		return n
+	}
+	if info, ok := Default().RegionInfo(c); ok {
+		return isoName(info.Name)
+	}
+	return string(c)
+}
+
+// LocalName returns the region's name in the official language of its
+// country, falling back to the ISO 3166-2 name.
+func (c Region) LocalName() string {
+	if n, ok := Default().RegionLocalName(c); ok {
+		return n
+	}
 	return string(c)
 }
 
+// NameIn returns the region's name in language l as defined by CLDR.
+// When no translation exists, the English name is returned.
+func (c Region) NameIn(l Language) string {
+	if n, ok := Default().RegionNameIn(c, l); ok {
+		return n
+	}
+	return c.String()
+}
+
+// Info returns the region's ISO 3166-2 data.
+func (c Region) Info() RegionInfo {
+	info, _ := Default().RegionInfo(c)
+	return info
+}
+
+// Type returns the region's subdivision category, e.g. "State".
+func (c Region) Type() string {
+	return c.Info().Type
+}
+
+// GPS returns the coordinates of the region's centroid. Regions without
+// coordinate data return false.
+func (c Region) GPS() (float64, float64, bool) {
+	return Default().RegionGPS(c)
+}
+
+// BoundingBox returns the region's bounding box. Regions without
+// coordinate data return false.
+func (c Region) BoundingBox() (BoundingBox, bool) {
+	return Default().RegionBounds(c)
+}
+
+// Contains returns true when the point at lat, lon lies within the
+// region's boundary.
+func (c Region) Contains(lat, lon float64) bool {
+	return Default().RegionContains(c, lat, lon)
+}
+
+// Parent returns the enclosing subdivision or RegionUndefined for top
+// level subdivisions.
+func (c Region) Parent() Region {
+	return c.Info().Parent
+}
+
 func (r Region) Country() Country {
 	if !r.IsValid() {
 		return Country("")
@@ -0,0 +1,115 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"testing"
+)
+
+func TestRegionNames(t *testing.T) {
+	old := Default()
+	r, err := old.Extend(func(b *Builder) error {
+		return b.SetRegionInfo("BE-ZZZ", RegionInfo{Name: "Bruxelles; Brussel", Type: "Region"})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetDefault(r)
+	defer SetDefault(old)
+
+	tests := []struct {
+		code  Region
+		name  string // String
+		local string // LocalName
+	}{
+		{"JP-13", "Tokyo", "東京都"},
+		{"DE-BY", "Bavaria", "Bayern"},
+		{"AT-9", "Vienna", "Wien"},
+		{"CH-GE", "Geneva", "Genève"},
+		{"US-CA", "California", "California"},
+		{"ES-CT", "Catalonia", "Catalunya"},
+		{"ES-GI", "Girona", "Girona"},
+		{"BE-ZZZ", "Bruxelles", "Bruxelles"},
+		{"DE-XX", "DE-XX", "DE-XX"},
+	}
+	for _, tt := range tests {
+		if got := tt.code.String(); got != tt.name {
+			t.Errorf("%s: String() = %q, want %q", tt.code, got, tt.name)
+		}
+		if got := tt.code.LocalName(); got != tt.local {
+			t.Errorf("%s: LocalName() = %q, want %q", tt.code, got, tt.local)
+		}
+	}
+}
+
+func TestRegionNameIn(t *testing.T) {
+	tests := []struct {
+		code Region
+		lang Language
+		want string
+	}{
+		{"JP-13", "jpn", "東京都"},
+		{"DE-BY", "deu", "Bayern"},
+		{"DE-BY", LanguageEnglish, "Bavaria"},
+		{"DE-BY", "fra", "Bavaria"}, // no translation, English name
+		{"ES-GI", "deu", "Girona"},  // no English name, ISO name
+		{"FR-IDF", "xxx", "Île-de-France"},
+	}
+	for _, tt := range tests {
+		if got := tt.code.NameIn(tt.lang); got != tt.want {
+			t.Errorf("%s: NameIn(%s) = %q, want %q", tt.code, tt.lang, got, tt.want)
+		}
+	}
+}
+
+func TestRegionInfo(t *testing.T) {
+	tests := []struct {
+		code   Region
+		typ    string
+		parent Region
+	}{
+		{"DE-BY", "Land", ""},
+		{"JP-13", "Prefecture", ""},
+		{"FR-75", "Metropolitan department", "FR-IDF"},
+		{"BE-VAN", "Province", "BE-VLG"},
+		{"ES-B", "Province", "ES-CT"},
+		{"DE-XX", "", ""},
+	}
+	for _, tt := range tests {
+		if got := tt.code.Type(); got != tt.typ {
+			t.Errorf("%s: Type() = %q, want %q", tt.code, got, tt.typ)
+		}
+		if got := tt.code.Parent(); got != tt.parent {
+			t.Errorf("%s: Parent() = %q, want %q", tt.code, got, tt.parent)
+		}
+	}
+	if got := Country("JP").Languages(); len(got) != 1 || got[0] != "jpn" {
+		t.Errorf("JP: Languages() = %v", got)
+	}
+}
+
+func TestParseRegionAliases(t *testing.T) {
+	tests := []struct {
+		code string
+		want Region
+	}{
+		{"CN-21", "CN-LN"},
+		{"cn-21", "CN-LN"},
+		{"MX-DIF", "MX-CMX"},
+		{"GR-A1", "GR-I"},
+		{"NO-02", "NO-30"},
+		{"DE-BY", "DE-BY"},
+		{"XX-01", RegionUndefined},
+	}
+	for _, tt := range tests {
+		if got := ParseRegion(tt.code); got != tt.want {
+			t.Errorf("ParseRegion(%q) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+	for old, r := range region_aliases {
+		if _, ok := Default().RegionInfo(r); !ok {
+			t.Errorf("%s: alias of unknown region %s", old, r)
+		}
+	}
+}
@@ -0,0 +1,1012 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+)
+
+// Registry is an immutable set of country, currency and language tables.
+// All lookups in this package go through the default registry which is
+// built from the generated tables at init and keeps its own copy of them,
+// so changing the exported tables after init has no effect on lookups.
+// Use Extend to derive a registry with changes applied and SetDefault to
+// install it.
+type Registry struct {
+	parseMode     ParseMode
+	countryCodes  []string
+	countryNames  map[string]string
+	officialNames map[string]string
+	localNames    map[string]string // keyed by language:country
+	countryGPS    map[string][2]float64
+	countryInfo   map[string]CountryInfo
+	countryLangs  map[string][]Language // official languages by country
+	countryBounds map[string]BoundingBox
+	regions       map[string]RegionInfo
+	regionNames   map[string]string // keyed by language:region
+	regionGPS     map[string][2]float64
+	regionBounds  map[string]BoundingBox
+	regionShapes  map[string][][]float64
+	countryShapes map[string][][]float64
+	dependencies  map[string]Dependency
+	blocNames     map[Bloc]string
+	blocMembers   map[Bloc][]Membership
+	currencyCodes []string
+	historicCodes []string // withdrawn currency codes, sorted
+	currencies    map[string]currency
+	languages     map[string]string // ISO 639-1, 639-2/B and 639-2/T to 639-2/T
+
+	// code history, shared between clones and never modified
+	formerCountries []FormerCountry // ISO 3166-3
+	countryHistory  []Assignment    // ISO 3166-1
+	currencyHistory []Assignment    // ISO 4217
+}
+
+var (
+	registry   atomic.Value // *Registry
+	registryMu sync.Mutex   // serializes updates of the default registry
+)
+
+func init() {
+	registry.Store(NewRegistry())
+}
+
+// NewRegistry returns a registry built from the generated tables.
+func NewRegistry() *Registry {
+	r := &Registry{
+		parseMode:     ParseDefault,
+		countryCodes:  make([]string, len(ISO_3166_1_COUNTRY_CODES)),
+		countryNames:  make(map[string]string, len(country_names)),
+		officialNames: make(map[string]string, len(country_official_names)),
+		localNames:    make(map[string]string, len(country_names_cldr)),
+		countryGPS:    make(map[string][2]float64, len(country_gps)),
+		countryInfo:   make(map[string]CountryInfo, len(country_info)),
+		countryLangs:  make(map[string][]Language, len(country_languages)),
+		countryBounds: make(map[string]BoundingBox, len(country_bounds)+len(country_small_bounds)),
+		regions:       make(map[string]RegionInfo, len(region_info)),
+		regionNames:   make(map[string]string, len(region_names_cldr)),
+		regionGPS:     make(map[string][2]float64, len(region_gps)),
+		regionBounds:  make(map[string]BoundingBox, len(region_bounds)),
+		regionShapes:  make(map[string][][]float64),
+		countryShapes: make(map[string][][]float64, len(country_shapes)),
+		dependencies:  make(map[string]Dependency, len(country_dependencies)),
+		blocNames:     make(map[Bloc]string, len(bloc_names)),
+		blocMembers:   make(map[Bloc][]Membership, len(bloc_members)),
+		currencyCodes: make([]string, len(ISO_4217_CURRENCY_CODES)),
+		currencies:    make(map[string]currency, len(currencies)),
+		languages:     make(map[string]string),
+
+		formerCountries: make([]FormerCountry, 0, len(iso_3166_3_codes)),
+		countryHistory:  append([]Assignment(nil), iso_3166_1_history...),
+		currencyHistory: append([]Assignment(nil), iso_4217_history...),
+	}
+	copy(r.countryCodes, ISO_3166_1_COUNTRY_CODES)
+	for k, v := range country_names {
+		r.countryNames[k] = v
+	}
+	for k, v := range country_official_names {
+		r.officialNames[k] = v
+	}
+	for k, v := range country_names_cldr {
+		r.localNames[k] = v
+	}
+	for k, v := range country_gps {
+		r.countryGPS[k] = v
+	}
+	for k, v := range country_info {
+		r.countryInfo[k] = v
+	}
+	for k, v := range country_languages {
+		r.countryLangs[k] = v
+	}
+	for k, v := range country_bounds {
+		r.countryBounds[k] = v
+	}
+	for k, v := range country_small_bounds {
+		r.countryBounds[k] = v
+	}
+	for k, v := range region_info {
+		r.regions[k] = v
+	}
+	for k, v := range region_names_cldr {
+		r.regionNames[k] = v
+	}
+	for k, v := range region_gps {
+		r.regionGPS[k] = v
+	}
+	for k, v := range region_bounds {
+		r.regionBounds[k] = v
+	}
+	for k, v := range country_shapes {
+		r.countryShapes[k] = v
+	}
+	for k, v := range country_dependencies {
+		r.dependencies[k] = v
+	}
+	for k, v := range bloc_names {
+		r.blocNames[k] = v
+	}
+	for k, v := range bloc_members {
+		r.blocMembers[k] = v
+	}
+	copy(r.currencyCodes, ISO_4217_CURRENCY_CODES)
+	for k, v := range currencies {
+		r.currencies[k] = v
+	}
+	sort.Strings(r.currencyCodes)
+	for _, a := range r.currencyHistory {
+		if !a.To.IsZero() && !contains(r.currencyCodes, a.Code) && !contains(r.historicCodes, a.Code) {
+			r.historicCodes = insert(r.historicCodes, a.Code)
+		}
+	}
+	for _, x := range ISO_639_2B_1998_CODES {
+		if v, ok := ISO_639_2B_TO_2T_MAP[x]; ok {
+			r.languages[x] = v
+		}
+	}
+	for _, x := range ISO_639_1_2002_CODES {
+		if v, ok := ISO_639_1_TO_2T_MAP[x]; ok {
+			r.languages[x] = v
+		}
+	}
+	for _, x := range ISO_639_2T_1998_CODES {
+		r.languages[x] = x
+	}
+	for _, v := range iso_3166_3_codes {
+		v.Successors = append([]string(nil), v.Successors...)
+		r.formerCountries = append(r.formerCountries, v)
+	}
+	sort.Slice(r.formerCountries, func(i, j int) bool {
+		a, b := r.formerCountries[i], r.formerCountries[j]
+		return a.Code < b.Code || a.Code == b.Code && a.Withdrawn.Before(b.Withdrawn)
+	})
+	return r
+}
+
+// Default returns the registry used by the package level functions.
+func Default() *Registry {
+	return registry.Load().(*Registry)
+}
+
+// SetDefault installs r as the registry used by the package level functions.
+func SetDefault(r *Registry) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry.Store(r)
+}
+
+// updateDefault applies fn to a copy of the default registry and installs
+// the result unless fn fails.
+func updateDefault(fn func(*Builder) error) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	r, err := Default().Extend(fn)
+	if err != nil {
+		return err
+	}
+	registry.Store(r)
+	return nil
+}
+
+// ParseCountry returns the country for an ISO 3166-1 alpha-2 code or
+// CountryUndefined when the code is not accepted by the registry's parse
+// mode. The default mode accepts all codes in the country list.
+func (r *Registry) ParseCountry(c string) Country {
+	return r.ParseCountryMode(c, r.parseMode)
+}
+
+// ParseCountryMode returns the country for an ISO 3166-1 alpha-2 code or
+// CountryUndefined when the code is not accepted by mode m.
+func (r *Registry) ParseCountryMode(c string, m ParseMode) Country {
+	c = strings.ToUpper(c)
+	if a, ok := country_aliases[c]; ok && m&ParseNormalize != 0 {
+		c = a
+	}
+	if _, ok := r.countryNames[c]; ok && m&ParseListed != 0 {
+		return Country(c)
+	}
+	if _, ok := r.formerCountry(c, time.Time{}); ok && m&ParseFormer != 0 {
+		if _, listed := r.countryNames[c]; !listed {
+			return Country(c)
+		}
+	}
+	var ok bool
+	switch r.CountryStatus(Country(c)) {
+	case CountryStatusOfficial:
+		ok = m&ParseOfficial != 0
+	case CountryStatusExceptional:
+		ok = m&ParseExceptional != 0
+	case CountryStatusTransitional:
+		ok = m&ParseTransitional != 0
+	case CountryStatusIndeterminate:
+		ok = m&ParseIndeterminate != 0
+	case CountryStatusUserAssigned:
+		ok = m&ParseUserAssigned != 0
+	}
+	if ok {
+		return Country(c)
+	}
+	return CountryUndefined
+}
+
+// CountryStatus returns the assignment status of country code c. Codes
+// in the country list which are neither reserved nor user-assigned are
+// considered officially assigned.
+func (r *Registry) CountryStatus(c Country) CountryStatus {
+	code := strings.ToUpper(string(c))
+	if v, ok := country_reservations[code]; ok {
+		return v.Status
+	}
+	if isUserAssigned(code) {
+		return CountryStatusUserAssigned
+	}
+	if _, ok := r.countryNames[code]; ok {
+		return CountryStatusOfficial
+	}
+	if _, ok := r.formerCountry(code, time.Time{}); ok {
+		return CountryStatusFormer
+	}
+	return CountryStatusUnassigned
+}
+
+// CountryCodes returns a copy of the list of country codes.
+func (r *Registry) CountryCodes() []string {
+	return append([]string(nil), r.countryCodes...)
+}
+
+// CountryName returns the English short name of country c.
+func (r *Registry) CountryName(c Country) (string, bool) {
+	n, ok := r.countryNames[string(c)]
+	return n, ok
+}
+
+// CountryOfficialName returns the official long form name of country c.
+func (r *Registry) CountryOfficialName(c Country) (string, bool) {
+	n, ok := r.officialNames[string(c)]
+	return n, ok
+}
+
+// CountryNameIn returns the CLDR name of country c in language l.
+func (r *Registry) CountryNameIn(c Country, l Language) (string, bool) {
+	n, ok := r.localNames[string(l)+":"+string(c)]
+	return n, ok
+}
+
+// CountryGPS returns the coordinates of the geographic center of country c.
+func (r *Registry) CountryGPS(c Country) (float64, float64, bool) {
+	gps, ok := r.countryGPS[string(c)]
+	return gps[0], gps[1], ok
+}
+
+// CountryInfo returns metadata for country c with a copy of its calling
+// codes.
+func (r *Registry) CountryInfo(c Country) (CountryInfo, bool) {
+	info, ok := r.countryInfo[string(c)]
+	info.CallingCodes = append([]string(nil), info.CallingCodes...)
+	return info, ok
+}
+
+// CountryLanguages returns the official languages of country c.
+func (r *Registry) CountryLanguages(c Country) []Language {
+	return append([]Language(nil), r.countryLangs[string(c)]...)
+}
+
+// CountryBounds returns the bounding box of country c.
+func (r *Registry) CountryBounds(c Country) (BoundingBox, bool) {
+	bb, ok := r.countryBounds[string(c)]
+	return bb, ok
+}
+
+// CountryContains returns true when the point at lat, lon lies within the
+// boundary of country c. Countries without boundary are approximated by
+// their bounding box.
+func (r *Registry) CountryContains(c Country, lat, lon float64) bool {
+	bb, ok := r.countryBounds[string(c)]
+	if !ok || !bb.Contains(lat, lon) {
+		return false
+	}
+	shape, ok := r.countryShapes[string(c)]
+	return !ok || containsPoint(shape, lat, lon)
+}
+
+// maxCoastDistance is the distance in degrees of latitude up to which
+// points outside all boundaries resolve to the nearest country. It covers
+// the error of simplified coastlines.
+const maxCoastDistance = 0.25
+
+// CountryAt returns the country whose boundary contains the point at
+// lat, lon. Countries without boundary, i.e. small states like Monaco or
+// Singapore, are matched by their bounding box first and the smallest box
+// wins, since their neighbours' simplified boundaries often include them.
+// Points outside all boundaries resolve to the nearest country within
+// maxCoastDistance, otherwise CountryUndefined is returned.
+func (r *Registry) CountryAt(lat, lon float64) Country {
+	var (
+		best Country
+		area float64
+	)
+	for k, bb := range r.countryBounds {
+		if _, ok := r.countryShapes[k]; ok || !bb.Contains(lat, lon) {
+			continue
+		}
+		if a := bb.area(); best == CountryUndefined || a < area || (a == area && Country(k) < best) {
+			best, area = Country(k), a
+		}
+	}
+	if best != CountryUndefined {
+		return best
+	}
+	for _, c := range r.countryCodes {
+		if r.CountryContains(Country(c), lat, lon) {
+			return Country(c)
+		}
+	}
+	return r.nearestCountry(lat, lon)
+}
+
+// nearestCountry returns the country closest to the point at lat, lon
+// within maxCoastDistance or CountryUndefined.
+func (r *Registry) nearestCountry(lat, lon float64) Country {
+	var best Country
+	dist := maxCoastDistance
+	scale := math.Cos(lat * math.Pi / 180)
+	for k, bb := range r.countryBounds {
+		d := bb.distance(lat, lon, scale)
+		if d > dist {
+			continue
+		}
+		if shape, ok := r.countryShapes[k]; ok {
+			d = shapeDistance(shape, lat, lon, scale)
+		}
+		if d < dist || (d == dist && (best == CountryUndefined || Country(k) < best)) {
+			best, dist = Country(k), d
+		}
+	}
+	return best
+}
+
+// RegionInfo returns the ISO 3166-2 data of region c.
+func (r *Registry) RegionInfo(c Region) (RegionInfo, bool) {
+	info, ok := r.regions[string(c)]
+	return info, ok
+}
+
+// RegionNameIn returns the CLDR name of region c in language l.
+func (r *Registry) RegionNameIn(c Region, l Language) (string, bool) {
+	n, ok := r.regionNames[string(l)+":"+string(c)]
+	return n, ok
+}
+
+// RegionLocalName returns the name of region c in an official language of
+// its country. CLDR names are used when they match the ISO 3166-2 name or
+// when the ISO name is a romanisation, e.g. 東京都 for JP-13. Otherwise the
+// ISO name is returned.
+func (r *Registry) RegionLocalName(c Region) (string, bool) {
+	info, ok := r.regions[string(c)]
+	name := isoName(info.Name)
+	for _, l := range r.countryLangs[string(c.Country())] {
+		if n, found := r.regionNames[string(l)+":"+string(c)]; found && (n == name || !isLatin(n)) {
+			return n, true
+		}
+	}
+	return name, ok
+}
+
+// isoName returns the first of the names of an ISO 3166-2 entry. Entries
+// list names in several languages separated by semicolons or add them in
+// brackets, e.g. "Catalunya [Cataluña]".
+func isoName(s string) string {
+	if i := strings.IndexByte(s, ';'); i >= 0 {
+		s = s[:i]
+	}
+	s = strings.TrimSpace(s)
+	if i := strings.Index(s, " ["); i > 0 && strings.HasSuffix(s, "]") {
+		s = s[:i]
+	}
+	return s
+}
+
+// isLatin returns true when all letters of s are in Latin script.
+func isLatin(s string) bool {
+	for _, c := range s {
+		if unicode.IsLetter(c) && !unicode.Is(unicode.Latin, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// RegionGPS returns the coordinates of the centroid of region c.
+func (r *Registry) RegionGPS(c Region) (float64, float64, bool) {
+	gps, ok := r.regionGPS[string(c)]
+	return gps[0], gps[1], ok
+}
+
+// RegionBounds returns the bounding box of region c.
+func (r *Registry) RegionBounds(c Region) (BoundingBox, bool) {
+	bb, ok := r.regionBounds[string(c)]
+	return bb, ok
+}
+
+// RegionContains returns true when the point at lat, lon lies within the
+// boundary of region c.
+func (r *Registry) RegionContains(c Region, lat, lon float64) bool {
+	bb, ok := r.regionBounds[string(c)]
+	if !ok || !bb.Contains(lat, lon) {
+		return false
+	}
+	return containsPoint(r.regionShapes[string(c)], lat, lon)
+}
+
+// RegionAt returns the region whose boundary contains the point at
+// lat, lon. When boundaries of nested regions are known, the region with
+// the smallest bounding box wins. Without a matching boundary the region
+// is estimated from bounding boxes and centroids, see nearestRegion.
+func (r *Registry) RegionAt(lat, lon float64) Region {
+	var (
+		best Region
+		area float64
+	)
+	for k, bb := range r.regionBounds {
+		if !bb.Contains(lat, lon) || !containsPoint(r.regionShapes[k], lat, lon) {
+			continue
+		}
+		if a := bb.area(); best == RegionUndefined || a < area || (a == area && Region(k) < best) {
+			best, area = Region(k), a
+		}
+	}
+	if best != RegionUndefined {
+		return best
+	}
+	return r.nearestRegion(lat, lon)
+}
+
+// nearestRegion returns the region without boundary whose bounding box
+// contains the point at lat, lon and whose centroid is closest to it.
+// Boxes of neighbouring regions overlap, so when the country at the point
+// is known only its regions are considered.
+func (r *Registry) nearestRegion(lat, lon float64) Region {
+	var (
+		best Region
+		dist float64
+	)
+	country := r.CountryAt(lat, lon)
+	scale := math.Cos(lat * math.Pi / 180)
+	for k, bb := range r.regionBounds {
+		if _, ok := r.regionShapes[k]; ok || !bb.Contains(lat, lon) {
+			continue
+		}
+		if country != CountryUndefined && !strings.HasPrefix(k, string(country)+"-") {
+			continue
+		}
+		gps, ok := r.regionGPS[k]
+		if !ok {
+			gps = [2]float64{(bb.South + bb.North) / 2, (bb.West + bb.East) / 2}
+		}
+		dlat, dlon := gps[0]-lat, (gps[1]-lon)*scale
+		if d := dlat*dlat + dlon*dlon; best == RegionUndefined || d < dist || (d == dist && Region(k) < best) {
+			best, dist = Region(k), d
+		}
+	}
+	return best
+}
+
+// SubdivisionType returns the most common category of the top level
+// subdivisions of country c.
+func (r *Registry) SubdivisionType(c Country) string {
+	count := make(map[string]int)
+	for k, v := range r.regions {
+		if v.Parent == RegionUndefined && strings.HasPrefix(k, string(c)+"-") {
+			count[v.Type]++
+		}
+	}
+	var typ string
+	for k, n := range count {
+		if n > count[typ] || (n == count[typ] && k < typ) {
+			typ = k
+		}
+	}
+	return typ
+}
+
+// CountryDependency returns the relation of territory c to its sovereign
+// state. Sovereign states return false.
+func (r *Registry) CountryDependency(c Country) (Dependency, bool) {
+	d, ok := r.dependencies[string(c)]
+	return d, ok
+}
+
+// CountryDependencies returns the territories belonging to sovereign
+// state c.
+func (r *Registry) CountryDependencies(c Country) CountrySet {
+	list := make([]Country, 0)
+	if !c.IsValid() {
+		return NewCountrySet()
+	}
+	for k, d := range r.dependencies {
+		if d.Sovereign == c && r.ParseCountry(k).IsValid() {
+			list = append(list, Country(k))
+		}
+	}
+	return NewCountrySet(list...)
+}
+
+// ParseBloc returns the bloc for code b or BlocUndefined when the bloc
+// is unknown.
+func (r *Registry) ParseBloc(b string) Bloc {
+	bb := Bloc(strings.ToUpper(b))
+	if _, ok := r.blocNames[bb]; ok {
+		return bb
+	}
+	return BlocUndefined
+}
+
+// BlocName returns the English name of bloc b.
+func (r *Registry) BlocName(b Bloc) (string, bool) {
+	n, ok := r.blocNames[b]
+	return n, ok
+}
+
+// BlocMembers returns the members of bloc b at time t.
+func (r *Registry) BlocMembers(b Bloc, t time.Time) CountrySet {
+	list := make([]Country, 0, len(r.blocMembers[b]))
+	for _, m := range r.blocMembers[b] {
+		if m.Covers(t) {
+			list = append(list, m.Country)
+		}
+	}
+	return NewCountrySet(list...)
+}
+
+// ParseCurrency returns the currency for an ISO 4217 code or a registered
+// custom code or CurrencyUndefined when the code is unknown. Withdrawn
+// ISO 4217 codes are accepted so stored amounts remain readable, use
+// IsHistoricCurrency to tell them apart.
+func (r *Registry) ParseCurrency(c string) Currency {
+	c = strings.ToUpper(c)
+	if contains(r.currencyCodes, c) || contains(r.historicCodes, c) {
+		return Currency(c)
+	}
+	return CurrencyUndefined
+}
+
+// IsHistoricCurrency returns true when c is a withdrawn currency code that
+// is no longer in the current list.
+func (r *Registry) IsHistoricCurrency(c Currency) bool {
+	return contains(r.historicCodes, string(c))
+}
+
+// CurrencyCodes returns a copy of the sorted list of currency codes.
+func (r *Registry) CurrencyCodes() []string {
+	return append([]string(nil), r.currencyCodes...)
+}
+
+func (r *Registry) currency(c Currency) (currency, bool) {
+	cc, ok := r.currencies[string(c)]
+	return cc, ok
+}
+
+// ParseLanguage returns the ISO 639-2/T language for an ISO 639-1,
+// ISO 639-2/B or ISO 639-2/T code or LanguageUndefined when the code
+// is unknown.
+func (r *Registry) ParseLanguage(l string) Language {
+	if v, ok := r.languages[strings.ToLower(l)]; ok {
+		return Language(v)
+	}
+	return LanguageUndefined
+}
+
+// Extend returns a copy of r with the changes made by fn applied. The
+// receiver is not modified. When fn returns an error, Extend returns
+// the error and no registry.
+func (r *Registry) Extend(fn func(*Builder) error) (*Registry, error) {
+	b := &Builder{r: r.clone()}
+	if err := fn(b); err != nil {
+		return nil, err
+	}
+	sort.Strings(b.r.currencyCodes)
+	return b.r, nil
+}
+
+func (r *Registry) clone() *Registry {
+	c := &Registry{
+		parseMode:     r.parseMode,
+		countryCodes:  append([]string(nil), r.countryCodes...),
+		countryNames:  make(map[string]string, len(r.countryNames)),
+		officialNames: make(map[string]string, len(r.officialNames)),
+		localNames:    make(map[string]string, len(r.localNames)),
+		countryGPS:    make(map[string][2]float64, len(r.countryGPS)),
+		countryInfo:   make(map[string]CountryInfo, len(r.countryInfo)),
+		countryLangs:  make(map[string][]Language, len(r.countryLangs)),
+		countryBounds: make(map[string]BoundingBox, len(r.countryBounds)),
+		regions:       make(map[string]RegionInfo, len(r.regions)),
+		regionNames:   make(map[string]string, len(r.regionNames)),
+		regionGPS:     make(map[string][2]float64, len(r.regionGPS)),
+		regionBounds:  make(map[string]BoundingBox, len(r.regionBounds)),
+		regionShapes:  make(map[string][][]float64, len(r.regionShapes)),
+		countryShapes: make(map[string][][]float64, len(r.countryShapes)),
+		dependencies:  make(map[string]Dependency, len(r.dependencies)),
+		blocNames:     make(map[Bloc]string, len(r.blocNames)),
+		blocMembers:   make(map[Bloc][]Membership, len(r.blocMembers)),
+		currencyCodes: append([]string(nil), r.currencyCodes...),
+		historicCodes: append([]string(nil), r.historicCodes...),
+		currencies:    make(map[string]currency, len(r.currencies)),
+		languages:     make(map[string]string, len(r.languages)),
+
+		formerCountries: r.formerCountries,
+		countryHistory:  r.countryHistory,
+		currencyHistory: r.currencyHistory,
+	}
+	for k, v := range r.countryNames {
+		c.countryNames[k] = v
+	}
+	for k, v := range r.officialNames {
+		c.officialNames[k] = v
+	}
+	for k, v := range r.localNames {
+		c.localNames[k] = v
+	}
+	for k, v := range r.countryGPS {
+		c.countryGPS[k] = v
+	}
+	for k, v := range r.countryInfo {
+		c.countryInfo[k] = v
+	}
+	for k, v := range r.countryLangs {
+		c.countryLangs[k] = v
+	}
+	for k, v := range r.countryBounds {
+		c.countryBounds[k] = v
+	}
+	for k, v := range r.regions {
+		c.regions[k] = v
+	}
+	for k, v := range r.regionNames {
+		c.regionNames[k] = v
+	}
+	for k, v := range r.regionGPS {
+		c.regionGPS[k] = v
+	}
+	for k, v := range r.regionBounds {
+		c.regionBounds[k] = v
+	}
+	for k, v := range r.regionShapes {
+		c.regionShapes[k] = v
+	}
+	for k, v := range r.countryShapes {
+		c.countryShapes[k] = v
+	}
+	for k, v := range r.dependencies {
+		c.dependencies[k] = v
+	}
+	for k, v := range r.blocNames {
+		c.blocNames[k] = v
+	}
+	for k, v := range r.blocMembers {
+		c.blocMembers[k] = v
+	}
+	for k, v := range r.currencies {
+		c.currencies[k] = v
+	}
+	for k, v := range r.languages {
+		c.languages[k] = v
+	}
+	return c
+}
+
+// Builder collects changes to a registry copy inside Registry.Extend.
+type Builder struct {
+	r *Registry
+}
+
+func remove(list []string, s string) []string {
+	for i, v := range list {
+		if v == s {
+			return append(list[:i], list[i+1:]...)
+		}
+	}
+	return list
+}
+
+// contains reports whether sorted list holds s.
+func contains(list []string, s string) bool {
+	i := sort.SearchStrings(list, s)
+	return i < len(list) && list[i] == s
+}
+
+// insert adds s to sorted list.
+func insert(list []string, s string) []string {
+	i := sort.SearchStrings(list, s)
+	list = append(list, "")
+	copy(list[i+1:], list[i:])
+	list[i] = s
+	return list
+}
+
+// SetParseMode sets the codes accepted by ParseCountry and by the text
+// and SQL conversion of countries.
+func (b *Builder) SetParseMode(m ParseMode) {
+	b.r.parseMode = m
+}
+
+// SetCountry adds country code c or renames an existing country.
+func (b *Builder) SetCountry(c, name string) error {
+	c = strings.ToUpper(c)
+	if len(c) != 2 || strings.Trim(c, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return fmt.Errorf("iso: invalid ISO 3166-1 alpha-2 country code '%s'", c)
+	}
+	if _, ok := b.r.countryNames[c]; !ok {
+		b.r.countryCodes = append(b.r.countryCodes, c)
+	}
+	b.r.countryNames[c] = name
+	return nil
+}
+
+// SetCountryOfficialName sets the official long form name of country c.
+func (b *Builder) SetCountryOfficialName(c Country, name string) {
+	b.r.officialNames[string(c)] = name
+}
+
+// SetCountryNameIn sets the name of country c in language l.
+func (b *Builder) SetCountryNameIn(c Country, l Language, name string) {
+	b.r.localNames[string(l)+":"+string(c)] = name
+}
+
+// SetCountryGPS sets the coordinates of the geographic center of country c.
+func (b *Builder) SetCountryGPS(c Country, lat, lon float64) {
+	b.r.countryGPS[string(c)] = [2]float64{lat, lon}
+}
+
+// SetCountryInfo sets the metadata of country c.
+func (b *Builder) SetCountryInfo(c Country, info CountryInfo) {
+	info.CallingCodes = append([]string(nil), info.CallingCodes...)
+	b.r.countryInfo[string(c)] = info
+}
+
+// SetCountryLanguages sets the official languages of country c.
+func (b *Builder) SetCountryLanguages(c Country, list ...Language) {
+	b.r.countryLangs[string(c)] = append([]Language(nil), list...)
+}
+
+// SetCountryShape sets the boundary of country c as a list of rings with
+// alternating longitude and latitude values. Holes are separate rings.
+// The bounding box is derived from the boundary.
+func (b *Builder) SetCountryShape(c Country, rings [][]float64) error {
+	bb, ok := shapeBounds(rings)
+	if !ok {
+		return fmt.Errorf("iso: invalid boundary for country '%s'", string(c))
+	}
+	b.r.countryBounds[string(c)] = bb
+	b.r.countryShapes[string(c)] = copyShape(rings)
+	return nil
+}
+
+// RemoveCountry removes country code c.
+func (b *Builder) RemoveCountry(c Country) {
+	b.r.countryCodes = remove(b.r.countryCodes, string(c))
+	delete(b.r.countryNames, string(c))
+	delete(b.r.officialNames, string(c))
+	delete(b.r.countryGPS, string(c))
+	delete(b.r.countryInfo, string(c))
+	delete(b.r.countryLangs, string(c))
+	delete(b.r.countryBounds, string(c))
+	delete(b.r.countryShapes, string(c))
+	delete(b.r.dependencies, string(c))
+	for k := range b.r.regions {
+		if strings.HasPrefix(k, string(c)+"-") {
+			b.RemoveRegion(Region(k))
+		}
+	}
+	for k := range b.r.localNames {
+		if strings.HasSuffix(k, ":"+string(c)) {
+			delete(b.r.localNames, k)
+		}
+	}
+}
+
+// SetRegionInfo adds region c or replaces its ISO 3166-2 data.
+func (b *Builder) SetRegionInfo(c Region, info RegionInfo) error {
+	ff := strings.SplitN(string(c), "-", 2)
+	if len(ff) != 2 || ff[1] == "" || b.r.ParseCountry(ff[0]) != Country(ff[0]) {
+		return fmt.Errorf("iso: invalid ISO region code '%s'", string(c))
+	}
+	b.r.regions[string(c)] = info
+	return nil
+}
+
+// SetRegionShape sets the boundary of region c as a list of rings with
+// alternating longitude and latitude values. Holes are separate rings.
+// The bounding box and centroid are derived from the boundary.
+func (b *Builder) SetRegionShape(c Region, rings [][]float64) error {
+	bb, ok := shapeBounds(rings)
+	if !ok {
+		return fmt.Errorf("iso: invalid boundary for region '%s'", string(c))
+	}
+	shape := copyShape(rings)
+	lat, lon := shapeCenter(shape)
+	b.r.regionBounds[string(c)] = bb
+	b.r.regionShapes[string(c)] = shape
+	b.r.regionGPS[string(c)] = [2]float64{lat, lon}
+	return nil
+}
+
+func copyShape(rings [][]float64) [][]float64 {
+	shape := make([][]float64, len(rings))
+	for i, ring := range rings {
+		shape[i] = append([]float64(nil), ring...)
+	}
+	return shape
+}
+
+// SetRegionNameIn sets the name of region c in language l.
+func (b *Builder) SetRegionNameIn(c Region, l Language, name string) {
+	b.r.regionNames[string(l)+":"+string(c)] = name
+}
+
+// RemoveRegion removes region c.
+func (b *Builder) RemoveRegion(c Region) {
+	delete(b.r.regions, string(c))
+	delete(b.r.regionBounds, string(c))
+	delete(b.r.regionShapes, string(c))
+	delete(b.r.regionGPS, string(c))
+	for k := range b.r.regionNames {
+		if strings.HasSuffix(k, ":"+string(c)) {
+			delete(b.r.regionNames, k)
+		}
+	}
+}
+
+// SetCountryDependency marks country c as a territory of another
+// country. Use an undefined sovereign for territories without one.
+func (b *Builder) SetCountryDependency(c Country, d Dependency) error {
+	if d.Sovereign == c {
+		return fmt.Errorf("iso: country '%s' cannot depend on itself", string(c))
+	}
+	if _, ok := b.r.dependencies[string(d.Sovereign)]; ok && d.Sovereign.IsValid() {
+		return fmt.Errorf("iso: sovereign '%s' of country '%s' is a dependency", string(d.Sovereign), string(c))
+	}
+	b.r.dependencies[string(c)] = d
+	return nil
+}
+
+// RemoveCountryDependency marks country c as a sovereign state.
+func (b *Builder) RemoveCountryDependency(c Country) {
+	delete(b.r.dependencies, string(c))
+}
+
+// SetBloc adds bloc code b or replaces the name and memberships of an
+// existing bloc.
+func (b *Builder) SetBloc(code, name string, members []Membership) error {
+	bb, err := parseBlocCode(code)
+	if err != nil {
+		return err
+	}
+	for _, m := range members {
+		if !m.Country.IsValid() {
+			return fmt.Errorf("iso: undefined country in bloc '%s'", string(bb))
+		}
+	}
+	b.r.blocNames[bb] = name
+	b.r.blocMembers[bb] = append([]Membership(nil), members...)
+	return nil
+}
+
+// RemoveBloc removes bloc code b.
+func (b *Builder) RemoveBloc(bb Bloc) {
+	delete(b.r.blocNames, bb)
+	delete(b.r.blocMembers, bb)
+}
+
+// SetCurrency adds currency code c or renames an existing currency. New
+// currencies use the symbol and number of decimal digits given, the
+// symbol of existing currencies is only changed when not empty.
+func (b *Builder) SetCurrency(c, name, symbol string, digits int) error {
+	code, err := parseCurrencyCode(c)
+	if err != nil {
+		return err
+	}
+	cc, ok := b.r.currencies[code]
+	if !ok {
+		cc = newCurrency(code)
+		cc.SubUnitToUnit = int64(math.Pow10(digits))
+		cc.SubUnitPrecision = digits
+		cc.CashPrecision = digits
+	}
+	cc.Name = name
+	if symbol != "" {
+		cc.Symbol = symbol
+	}
+	b.setCurrency(code, cc)
+	return nil
+}
+
+// SetCustomCurrency adds or replaces a currency that is not part of ISO
+// 4217, e.g. a crypto-asset or loyalty points. Codes of ISO 4217
+// currencies cannot be used.
+func (b *Builder) SetCustomCurrency(def CustomCurrency) error {
+	code, err := parseCustomCurrencyCode(def.Code)
+	if err != nil {
+		return err
+	}
+	if isISOCurrency(code) {
+		return fmt.Errorf("iso: '%s' is an ISO 4217 currency code", code)
+	}
+	if def.Decimals < 0 {
+		return fmt.Errorf("iso: invalid number of decimals %d for currency %s", def.Decimals, code)
+	}
+	cc := newCurrency(code)
+	if def.Name != "" {
+		cc.Name = def.Name
+	}
+	if def.Symbol != "" {
+		cc.Symbol = def.Symbol
+	}
+	cc.SymbolFirst = def.SymbolFirst
+	cc.SubUnit = def.SubUnit
+	cc.SubUnitPrecision = def.Decimals
+	cc.CashPrecision = def.Decimals
+	cc.SubUnitToUnit = 0
+	if def.Decimals <= 18 {
+		cc.SubUnitToUnit = int64(math.Pow10(def.Decimals))
+	}
+	cc.Custom = true
+	b.setCurrency(code, cc)
+	return nil
+}
+
+func (b *Builder) setCurrency(code string, cc currency) {
+	if !contains(b.r.currencyCodes, code) {
+		b.r.currencyCodes = insert(b.r.currencyCodes, code)
+	}
+	b.r.historicCodes = remove(b.r.historicCodes, code)
+	b.r.currencies[code] = cc
+}
+
+// RemoveCurrency moves currency code c from the list of current codes to
+// the withdrawn codes. Formatting data is kept so amounts in retired
+// currencies can still be parsed and formatted.
+func (b *Builder) RemoveCurrency(c Currency) {
+	if !contains(b.r.currencyCodes, string(c)) {
+		return
+	}
+	b.r.currencyCodes = remove(b.r.currencyCodes, string(c))
+	if !b.r.currencies[string(c)].Custom {
+		b.r.historicCodes = insert(b.r.historicCodes, string(c))
+	}
+}
+
+// SetLanguage adds language code l as an alias of ISO 639-2/T code t.
+// Use l == t to add a new ISO 639-2/T code.
+func (b *Builder) SetLanguage(l, t string) error {
+	l, t = strings.ToLower(l), strings.ToLower(t)
+	if len(t) != 3 || (len(l) != 2 && len(l) != 3) {
+		return fmt.Errorf("iso: invalid ISO 639 language code '%s'", l)
+	}
+	if _, ok := b.r.languages[t]; !ok && l != t {
+		return fmt.Errorf("iso: unknown ISO 639-2/T language code '%s'", t)
+	}
+	b.r.languages[l] = t
+	return nil
+}
+
+// RemoveLanguage removes language code l and, for ISO 639-2/T codes,
+// all its aliases.
+func (b *Builder) RemoveLanguage(l string) {
+	l = strings.ToLower(l)
+	if b.r.languages[l] == l {
+		for k, v := range b.r.languages {
+			if v == l {
+				delete(b.r.languages, k)
+			}
+		}
+	}
+	delete(b.r.languages, l)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestCountryInfoCopiesCallingCodes(t *testing.T) {
+	info, ok := Default().CountryInfo("DE")
+	if !ok || len(info.CallingCodes) == 0 {
+		t.Fatalf("DE: no calling codes")
+	}
+	info.CallingCodes[0] = "+0"
+	Country("DE").Info().CallingCodes[0] = "+0"
+	Country("DE").CallingCodes()[0] = "+0"
+	if got := Country("DE").CallingCodes(); got[0] != "+49" {
+		t.Errorf("DE calling codes modified through a returned slice: %v", got)
+	}
+}
+
+func TestCallingCodes(t *testing.T) {
+	tests := []struct {
+		country Country
+		want    []string
+	}{
+		{"DE", []string{"+49"}},
+		{"US", []string{"+1"}},
+		{"CA", []string{"+1"}},
+		{"JM", []string{"+1876", "+1658"}},
+		{"DO", []string{"+1809", "+1829", "+1849"}},
+		{"RU", []string{"+7"}},
+		{"KZ", []string{"+7"}},
+		{"VA", []string{"+3906698"}},
+	}
+	for _, tt := range tests {
+		if got := tt.country.CallingCodes(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: CallingCodes() = %v, want %v", tt.country, got, tt.want)
+		}
+	}
+}
+
+// TestRegistryConcurrency is meant to run with go test -race.
+func TestRegistryConcurrency(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				if c := ParseCountry("DE"); !c.IsValid() {
+					t.Errorf("DE not valid")
+					return
+				}
+				Country("US").Info().CallingCodes[0] = "+0"
+				Country("US").CallingCodes()[0] = "+0"
+				Currency("EUR").Format(1234.5, nil)
+				ParseCurrency("EUR")
+				Default().CountryCodes()
+			}
+		}()
+	}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				r, err := Default().Extend(func(b *Builder) error {
+					b.SetCountryGPS("DE", float64(i), float64(j))
+					return b.SetCurrency(fmt.Sprintf("X%c%c", 'A'+i, 'A'+j), "Test", "T", 2)
+				})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				r.ParseCountry("DE")
+			}
+		}(i)
+	}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				code := fmt.Sprintf("RACE%c%c", 'A'+i, 'A'+j)
+				if err := RegisterCurrency(CustomCurrency{Code: code, Name: "Test", Decimals: 2}); err != nil {
+					t.Error(err)
+					return
+				}
+				in := "iso_code,custom,name\n" + code + ",true,Renamed\n"
+				if err := LoadCurrencies(strings.NewReader(in)); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// no update of the default registry is lost
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 20; j++ {
+			code := fmt.Sprintf("RACE%c%c", 'A'+i, 'A'+j)
+			if c := ParseCurrency(code); !c.IsValid() {
+				t.Errorf("%s: missing after concurrent updates", code)
+			}
+		}
+	}
+	if got := Country("US").CallingCodes(); got[0] != "+1" {
+		t.Errorf("US calling codes modified: %v", got)
+	}
+}
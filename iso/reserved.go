@@ -0,0 +1,130 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"strings"
+)
+
+// CountryStatus is the assignment status of an ISO 3166-1 alpha-2 code.
+type CountryStatus string
+
+const (
+	CountryStatusUnassigned    CountryStatus = "unassigned"
+	CountryStatusOfficial      CountryStatus = "official"
+	CountryStatusExceptional   CountryStatus = "exceptional"   // reserved at the request of a national body or organisation
+	CountryStatusTransitional  CountryStatus = "transitional"  // reserved after deletion from the standard
+	CountryStatusIndeterminate CountryStatus = "indeterminate" // used in other standards like vehicle registration codes
+	CountryStatusUserAssigned  CountryStatus = "user-assigned" // free for private use, e.g. XK for Kosovo
+	CountryStatusFormer        CountryStatus = "former"        // formerly used and listed in ISO 3166-3
+)
+
+// ParseMode selects which codes ParseCountry accepts. Modes can be
+// combined.
+type ParseMode int
+
+const (
+	ParseListed        ParseMode = 1 << iota // codes in the registry's country list, including XK
+	ParseOfficial                            // officially assigned codes
+	ParseExceptional                         // exceptionally reserved codes like EU, UK or UN
+	ParseTransitional                        // transitionally reserved codes like YU or ZR
+	ParseIndeterminate                       // indeterminately reserved codes like RA or WG
+	ParseUserAssigned                        // user-assigned codes AA, QM-QZ, XA-XZ and ZZ
+	ParseFormer                              // formerly used codes from ISO 3166-3 like DD or SU
+	ParseNormalize                           // replace aliases like UK and EL by the official code
+
+	ParseDefault = ParseListed
+	ParseStrict  = ParseOfficial
+)
+
+// Reserved ISO 3166-1 alpha-2 codes.
+// https://www.iso.org/glossary-for-iso-3166.html
+// https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2#Reserved_code_elements
+var country_reservations = map[string]struct {
+	Status CountryStatus
+	Name   string
+}{
+	"AC": {CountryStatusExceptional, "Ascension Island"},
+	"AN": {CountryStatusTransitional, "Netherlands Antilles"},
+	"BU": {CountryStatusTransitional, "Burma"},
+	"CP": {CountryStatusExceptional, "Clipperton Island"},
+	"CS": {CountryStatusTransitional, "Serbia and Montenegro"},
+	"DG": {CountryStatusExceptional, "Diego Garcia"},
+	"DY": {CountryStatusIndeterminate, "Benin"},
+	"EA": {CountryStatusExceptional, "Ceuta, Melilla"},
+	"EU": {CountryStatusExceptional, "European Union"},
+	"EW": {CountryStatusIndeterminate, "Estonia"},
+	"EZ": {CountryStatusExceptional, "Eurozone"},
+	"FL": {CountryStatusIndeterminate, "Liechtenstein"},
+	"FX": {CountryStatusExceptional, "France, Metropolitan"},
+	"IC": {CountryStatusExceptional, "Canary Islands"},
+	"JA": {CountryStatusIndeterminate, "Jamaica"},
+	"LF": {CountryStatusIndeterminate, "Libya Fezzan"},
+	"NT": {CountryStatusTransitional, "Neutral Zone"},
+	"PI": {CountryStatusIndeterminate, "Philippines"},
+	"RA": {CountryStatusIndeterminate, "Argentina"},
+	"RB": {CountryStatusIndeterminate, "Bolivia"},
+	"RC": {CountryStatusIndeterminate, "China"},
+	"RH": {CountryStatusIndeterminate, "Haiti"},
+	"RI": {CountryStatusIndeterminate, "Indonesia"},
+	"RL": {CountryStatusIndeterminate, "Lebanon"},
+	"RM": {CountryStatusIndeterminate, "Madagascar"},
+	"RN": {CountryStatusIndeterminate, "Niger"},
+	"RP": {CountryStatusIndeterminate, "Philippines"},
+	"SU": {CountryStatusExceptional, "USSR"},
+	"TA": {CountryStatusExceptional, "Tristan da Cunha"},
+	"TP": {CountryStatusTransitional, "East Timor"},
+	"UK": {CountryStatusExceptional, "United Kingdom"},
+	"UN": {CountryStatusExceptional, "United Nations"},
+	"WG": {CountryStatusIndeterminate, "Grenada"},
+	"WL": {CountryStatusIndeterminate, "Saint Lucia"},
+	"WV": {CountryStatusIndeterminate, "Saint Vincent"},
+	"YU": {CountryStatusTransitional, "Yugoslavia"},
+	"YV": {CountryStatusIndeterminate, "Venezuela"},
+	"ZR": {CountryStatusTransitional, "Zaire"},
+}
+
+// Aliases replaced by ParseNormalize. EL is not reserved in ISO 3166, but
+// used by the EU for Greece.
+var country_aliases = map[string]string{
+	"EL": "GR",
+	"FX": "FR",
+	"UK": "GB",
+}
+
+// isUserAssigned returns true for codes in the user-assigned ranges.
+func isUserAssigned(c string) bool {
+	if len(c) != 2 {
+		return false
+	}
+	switch {
+	case c == "AA", c == "ZZ":
+		return true
+	case c[0] == 'Q':
+		return c[1] >= 'M' && c[1] <= 'Z'
+	case c[0] == 'X':
+		return c[1] >= 'A' && c[1] <= 'Z'
+	}
+	return false
+}
+
+// ParseCountryMode works like ParseCountry, but accepts codes selected by
+// mode m instead of the registry's parse mode.
+func ParseCountryMode(c string, m ParseMode) Country {
+	return Default().ParseCountryMode(c, m)
+}
+
+// Status returns the assignment status of the country code.
+func (c Country) Status() CountryStatus {
+	return Default().CountryStatus(c)
+}
+
+// Normalize returns the official code for aliases like UK and EL. Other
+// codes are returned unchanged.
+func (c Country) Normalize() Country {
+	if a, ok := country_aliases[strings.ToUpper(string(c))]; ok {
+		return Country(a)
+	}
+	return c
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package iso
+
+import (
+	"testing"
+)
+
+func TestParseCountryMode(t *testing.T) {
+	tests := []struct {
+		in   string
+		mode ParseMode
+		want Country
+	}{
+		{"DE", ParseDefault, "DE"},
+		{"de", ParseStrict, "DE"},
+		{"XK", ParseDefault, "XK"},
+		{"XK", ParseStrict, CountryUndefined},
+		{"XK", ParseUserAssigned, "XK"},
+		{"EU", ParseDefault, CountryUndefined},
+		{"EU", ParseDefault | ParseExceptional, "EU"},
+		{"UK", ParseDefault, CountryUndefined},
+		{"UK", ParseDefault | ParseExceptional, "UK"},
+		{"uk", ParseDefault | ParseNormalize, "GB"},
+		{"EL", ParseDefault | ParseNormalize, "GR"},
+		{"EL", ParseDefault | ParseExceptional, CountryUndefined},
+		{"FX", ParseStrict | ParseNormalize, "FR"},
+		{"UN", ParseExceptional, "UN"},
+		{"YU", ParseDefault, CountryUndefined},
+		{"YU", ParseTransitional, "YU"},
+		{"RA", ParseIndeterminate, "RA"},
+		{"RA", ParseTransitional, CountryUndefined},
+		{"XA", ParseDefault, CountryUndefined},
+		{"XA", ParseUserAssigned, "XA"},
+		{"QM", ParseUserAssigned, "QM"},
+		{"QL", ParseUserAssigned, CountryUndefined},
+		{"ZZ", ParseUserAssigned, "ZZ"},
+		{"AA", ParseUserAssigned, "AA"},
+		{"DD", ParseDefault, CountryUndefined},
+		{"DD", ParseFormer, "DD"},
+		{"JX", ParseDefault | ParseExceptional | ParseTransitional | ParseIndeterminate | ParseUserAssigned | ParseFormer, CountryUndefined},
+		{"", ParseDefault, CountryUndefined},
+		{"DEU", ParseDefault, CountryUndefined},
+	}
+	for _, tt := range tests {
+		if got := ParseCountryMode(tt.in, tt.mode); got != tt.want {
+			t.Errorf("ParseCountryMode(%q, %d) = %q, want %q", tt.in, tt.mode, string(got), string(tt.want))
+		}
+	}
+}
+
+func TestCountryStatus(t *testing.T) {
+	tests := []struct {
+		code   Country
+		status CountryStatus
+		name   string
+	}{
+		{"DE", CountryStatusOfficial, "Germany"},
+		{"EU", CountryStatusExceptional, "European Union"},
+		{"UK", CountryStatusExceptional, "United Kingdom"},
+		{"YU", CountryStatusTransitional, "Yugoslavia, (Socialist) Federal Republic of"}, // ISO 3166-3 name
+		{"RA", CountryStatusIndeterminate, "Argentina"},
+		{"XK", CountryStatusUserAssigned, "Kosovo"},
+		{"XA", CountryStatusUserAssigned, "XA"},
+		{"JX", CountryStatusUnassigned, "JX"},
+	}
+	for _, tt := range tests {
+		if got := tt.code.Status(); got != tt.status {
+			t.Errorf("%s: Status() = %q, want %q", string(tt.code), got, tt.status)
+		}
+		if got := tt.code.String(); got != tt.name {
+			t.Errorf("%s: String() = %q, want %q", string(tt.code), got, tt.name)
+		}
+	}
+}
+
+func TestCountryNormalize(t *testing.T) {
+	tests := []struct {
+		in, want Country
+	}{
+		{"UK", "GB"},
+		{"uk", "GB"},
+		{"EL", "GR"},
+		{"FX", "FR"},
+		{"GB", "GB"},
+		{"EU", "EU"},
+	}
+	for _, tt := range tests {
+		if got := tt.in.Normalize(); got != tt.want {
+			t.Errorf("%s: Normalize() = %q, want %q", string(tt.in), string(got), string(tt.want))
+		}
+	}
+}
+
+func TestSetParseMode(t *testing.T) {
+	old := Default()
+	r, err := old.Extend(func(b *Builder) error {
+		b.SetParseMode(ParseDefault | ParseExceptional | ParseNormalize)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetDefault(r)
+	defer SetDefault(old)
+
+	tests := []struct {
+		in   string
+		want Country
+	}{
+		{"UK", "GB"},
+		{"EU", "EU"},
+		{"XA", CountryUndefined},
+	}
+	for _, tt := range tests {
+		if got := ParseCountry(tt.in); got != tt.want {
+			t.Errorf("ParseCountry(%q) = %q, want %q", tt.in, string(got), string(tt.want))
+		}
+	}
+	var c Country
+	if err := c.UnmarshalText([]byte("EU")); err != nil || c != "EU" {
+		t.Errorf("UnmarshalText(EU) = %q, %v", string(c), err)
+	}
+	if got := old.ParseCountry("EU"); got.IsValid() {
+		t.Errorf("Extend modified the original registry")
+	}
+}
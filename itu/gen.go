@@ -0,0 +1,235 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+//go:build ignore
+// +build ignore
+
+// This program generates tables.go from the libphonenumber metadata
+// snapshot in the data directory. Run it with go generate. A report of
+// added, removed and changed entries is written to stdout.
+//
+// The snapshot is a verbatim copy of data/metadata.xml.gz from the Go port
+// github.com/nyaruka/phonenumbers, which holds libphonenumber's territory
+// metadata as a gzipped PhoneMetadataCollection protocol buffer despite its
+// name. To refresh the tables, replace it with the file of a newer release
+// and update the release constant.
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/echa/code/internal/gen"
+)
+
+const (
+	snapshot = "data/metadata.xml.gz"
+	release  = "phonenumbers v1.8.1"
+)
+
+type desc struct {
+	Pattern string
+}
+
+type territory struct {
+	ID                       string
+	CountryCode              string
+	MainCountryForCode       bool
+	LeadingDigits            string
+	InternationalPrefix      string
+	NationalPrefix           string
+	NationalPrefixForParsing string
+	GeneralDesc              desc
+	FixedLine                desc
+	Mobile                   desc
+}
+
+func main() {
+	list, err := readMetadata(snapshot)
+	if err != nil {
+		log.Fatalf("%s: %v", snapshot, err)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+
+	var b bytes.Buffer
+	b.WriteString("package itu\n\n")
+	b.WriteString("import \"github.com/echa/code/iso\"\n\n")
+	b.WriteString("// Phone number metadata by ISO 3166-1 country code.\n")
+	b.WriteString("// https://github.com/google/libphonenumber\n")
+	b.WriteString("var phone_metadata = map[string]phoneMetadata{\n")
+	for _, t := range list {
+		// non-geographic entities use the id 001
+		if len(t.ID) != 2 {
+			continue
+		}
+		for _, p := range []string{
+			t.LeadingDigits,
+			t.InternationalPrefix,
+			t.NationalPrefixForParsing,
+			t.GeneralDesc.Pattern,
+			t.FixedLine.Pattern,
+			t.Mobile.Pattern,
+		} {
+			if _, err := regexp.Compile(clean(p)); err != nil {
+				log.Fatalf("%s: %s: %v", snapshot, t.ID, err)
+			}
+		}
+		fmt.Fprintf(&b, "\t%q: {%q, %t, %q, %q, %q, %q, %q, %q, %q},\n",
+			t.ID, t.CountryCode, t.MainCountryForCode, clean(t.LeadingDigits),
+			clean(t.InternationalPrefix), t.NationalPrefix, clean(t.NationalPrefixForParsing),
+			clean(t.GeneralDesc.Pattern), clean(t.FixedLine.Pattern), clean(t.Mobile.Pattern))
+	}
+	b.WriteString("}\n\n")
+
+	sum, err := gen.Checksum(snapshot)
+	if err != nil {
+		log.Fatal(err)
+	}
+	b.WriteString("// Upstream snapshots the tables were generated from, see Datasets.\n")
+	b.WriteString("var datasets = map[string]iso.Dataset{\n")
+	fmt.Fprintf(&b, "\t%q: {File: %q, Published: %q, Checksum: %q},\n", "libphonenumber", snapshot, release, sum)
+	b.WriteString("}\n")
+
+	if err := gen.WriteGoFile("tables.go", b.Bytes(), os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// clean removes the whitespace upstream uses to format long patterns.
+func clean(s string) string {
+	return strings.Join(strings.Fields(s), "")
+}
+
+// Field numbers of the PhoneMetadata and PhoneNumberDesc messages in
+// libphonenumber's phonemetadata.proto.
+const (
+	fieldMetadata                 = 1 // PhoneMetadataCollection.metadata
+	fieldGeneralDesc              = 1
+	fieldFixedLine                = 2
+	fieldMobile                   = 3
+	fieldID                       = 9
+	fieldCountryCode              = 10
+	fieldInternationalPrefix      = 11
+	fieldNationalPrefix           = 12
+	fieldNationalPrefixForParsing = 15
+	fieldMainCountryForCode       = 22
+	fieldLeadingDigits            = 23
+	fieldNationalNumberPattern    = 2 // PhoneNumberDesc.national_number_pattern
+)
+
+// readMetadata decodes the territories of a gzipped PhoneMetadataCollection.
+func readMetadata(name string) ([]territory, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+	coll, err := decode(buf)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]territory, 0, len(coll.bytes[fieldMetadata]))
+	for _, raw := range coll.bytes[fieldMetadata] {
+		m, err := decode(raw)
+		if err != nil {
+			return nil, err
+		}
+		t := territory{
+			ID:                       m.str(fieldID),
+			CountryCode:              strconv.FormatUint(m.varint[fieldCountryCode], 10),
+			MainCountryForCode:       m.varint[fieldMainCountryForCode] != 0,
+			LeadingDigits:            m.str(fieldLeadingDigits),
+			InternationalPrefix:      m.str(fieldInternationalPrefix),
+			NationalPrefix:           m.str(fieldNationalPrefix),
+			NationalPrefixForParsing: m.str(fieldNationalPrefixForParsing),
+		}
+		for num, d := range map[int]*desc{
+			fieldGeneralDesc: &t.GeneralDesc,
+			fieldFixedLine:   &t.FixedLine,
+			fieldMobile:      &t.Mobile,
+		} {
+			if raw := m.str(num); raw != "" {
+				sub, err := decode([]byte(raw))
+				if err != nil {
+					return nil, err
+				}
+				d.Pattern = sub.str(fieldNationalNumberPattern)
+			}
+		}
+		list = append(list, t)
+	}
+	return list, nil
+}
+
+// message holds the varint and length-delimited fields of a protocol
+// buffer message by field number. Fixed-size fields are skipped.
+type message struct {
+	varint map[int]uint64
+	bytes  map[int][][]byte
+}
+
+func decode(b []byte) (message, error) {
+	m := message{make(map[int]uint64), make(map[int][][]byte)}
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			return m, errors.New("invalid field key")
+		}
+		b = b[n:]
+		num := int(key >> 3)
+		switch key & 7 {
+		case 0:
+			v, n := binary.Uvarint(b)
+			if n <= 0 {
+				return m, fmt.Errorf("field %d: invalid varint", num)
+			}
+			m.varint[num] = v
+			b = b[n:]
+		case 1, 5:
+			size := 8
+			if key&7 == 5 {
+				size = 4
+			}
+			if len(b) < size {
+				return m, fmt.Errorf("field %d: truncated", num)
+			}
+			b = b[size:]
+		case 2:
+			l, n := binary.Uvarint(b)
+			if n <= 0 || l > uint64(len(b)-n) {
+				return m, fmt.Errorf("field %d: truncated", num)
+			}
+			m.bytes[num] = append(m.bytes[num], b[n:n+int(l)])
+			b = b[n+int(l):]
+		default:
+			return m, fmt.Errorf("field %d: unsupported wire type %d", num, key&7)
+		}
+	}
+	return m, nil
+}
+
+// str returns the first value of a string or message field.
+func (m message) str(num int) string {
+	if v := m.bytes[num]; len(v) > 0 {
+		return string(v[0])
+	}
+	return ""
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package itu
+
+//go:generate go run gen.go
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/echa/code/iso"
+)
+
+type phoneMetadata struct {
+	CallingCode              string
+	MainCountryForCode       bool
+	LeadingDigits            string
+	InternationalPrefix      string
+	NationalPrefix           string
+	NationalPrefixForParsing string
+	Pattern                  string
+	FixedLinePattern         string
+	MobilePattern            string
+}
+
+// phoneRegion is the compiled form of phoneMetadata.
+type phoneRegion struct {
+	country        iso.Country
+	code           string
+	main           bool
+	nationalPrefix string
+	leading        *regexp.Regexp
+	intlPrefix     *regexp.Regexp
+	prefix         *regexp.Regexp
+	general        *regexp.Regexp
+	fixed          *regexp.Regexp
+	mobile         *regexp.Regexp
+}
+
+var (
+	// a trunk prefix written after the calling code, e.g. +49 (0)30 123456
+	phoneTrunkPrefix = regexp.MustCompile(`^\+[ \x{00a0}]*(\d{1,3})[ \x{00a0}.-]*\(0\)`)
+
+	phoneOnce    sync.Once
+	phoneRegions map[iso.Country]*phoneRegion
+	phoneCodes   map[string][]*phoneRegion
+)
+
+// compilePrefix returns a regexp matching p at the start of the input.
+func compilePrefix(p string) *regexp.Regexp {
+	if p == "" {
+		return nil
+	}
+	return regexp.MustCompile("^(?:" + p + ")")
+}
+
+// compileFull returns a regexp matching p against the entire input.
+func compileFull(p string) *regexp.Regexp {
+	if p == "" {
+		return nil
+	}
+	return regexp.MustCompile("^(?:" + p + ")$")
+}
+
+func loadPhoneRegions() {
+	phoneOnce.Do(func() {
+		phoneRegions = make(map[iso.Country]*phoneRegion, len(phone_metadata))
+		phoneCodes = make(map[string][]*phoneRegion)
+		for k, v := range phone_metadata {
+			r := &phoneRegion{
+				country:        iso.Country(k),
+				code:           v.CallingCode,
+				main:           v.MainCountryForCode,
+				nationalPrefix: v.NationalPrefix,
+				leading:        compilePrefix(v.LeadingDigits),
+				intlPrefix:     compilePrefix(v.InternationalPrefix),
+				prefix:         compilePrefix(v.NationalPrefixForParsing),
+				general:        compileFull(v.Pattern),
+				fixed:          compileFull(v.FixedLinePattern),
+				mobile:         compileFull(v.MobilePattern),
+			}
+			phoneRegions[r.country] = r
+			phoneCodes[r.code] = append(phoneCodes[r.code], r)
+		}
+		for _, list := range phoneCodes {
+			sort.Slice(list, func(i, j int) bool { return list[i].country < list[j].country })
+		}
+	})
+}
+
+// matches returns true when national is a valid national number in
+// region r.
+func (r *phoneRegion) matches(national string) bool {
+	return r.general != nil && r.general.MatchString(national)
+}
+
+// stripNationalPrefix removes a trunk prefix like 0 in Germany, 1 in the
+// North American Numbering Plan or 8 in Russia from a nationally dialled
+// number.
+func (r *phoneRegion) stripNationalPrefix(digits string) string {
+	if r.prefix != nil {
+		if loc := r.prefix.FindStringIndex(digits); loc != nil && loc[1] > 0 {
+			if rest := digits[loc[1]:]; r.matches(rest) {
+				return rest
+			}
+		}
+	}
+	if r.nationalPrefix != "" && strings.HasPrefix(digits, r.nationalPrefix) {
+		if rest := digits[len(r.nationalPrefix):]; r.matches(rest) {
+			return rest
+		}
+	}
+	return digits
+}
+
+// E.164 phone number in international format, e.g. +4930123456
+type PhoneNumber string
+
+const (
+	PhoneNumberUndefined PhoneNumber = ""
+)
+
+// cleanPhoneNumber removes formatting characters from s and returns the
+// remaining digits. A leading + marks the number as international.
+func cleanPhoneNumber(s string) (string, bool, bool) {
+	s = strings.TrimSpace(s)
+	// drop the trunk prefix some people write after the calling code
+	if m := phoneTrunkPrefix.FindStringSubmatchIndex(s); m != nil {
+		loadPhoneRegions()
+		if _, ok := phoneCodes[s[m[2]:m[3]]]; ok {
+			s = s[:m[3]] + " " + s[m[1]:]
+		}
+	}
+	intl := strings.HasPrefix(s, "+")
+	if intl {
+		s = s[1:]
+	}
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == ' ', r == '-', r == '.', r == '/', r == '(', r == ')', r == '\u00a0':
+		default:
+			return "", false, false
+		}
+	}
+	if b.Len() == 0 || b.Len() > 17 {
+		return "", false, false
+	}
+	return b.String(), intl, true
+}
+
+// ParsePhoneNumber parses a phone number in international or national
+// format and returns it in E.164 format. National numbers are interpreted
+// using the numbering plan of country c, which may be undefined when only
+// international numbers are accepted. Numbers that are invalid in their
+// numbering plan return PhoneNumberUndefined.
+func ParsePhoneNumber(s string, c iso.Country) PhoneNumber {
+	digits, intl, ok := cleanPhoneNumber(s)
+	if !ok {
+		return PhoneNumberUndefined
+	}
+	loadPhoneRegions()
+	r := phoneRegions[c]
+	if !intl && r != nil && r.intlPrefix != nil {
+		if loc := r.intlPrefix.FindStringIndex(digits); loc != nil && loc[1] > 0 && loc[1] < len(digits) {
+			digits, intl = digits[loc[1]:], true
+		}
+	}
+	if intl {
+		if len(digits) > 15 {
+			return PhoneNumberUndefined
+		}
+		code, national := splitCallingCode(digits)
+		for _, rr := range phoneCodes[code] {
+			if rr.matches(national) {
+				return PhoneNumber("+" + digits)
+			}
+		}
+		return PhoneNumberUndefined
+	}
+	if r == nil {
+		return PhoneNumberUndefined
+	}
+	national := r.stripNationalPrefix(digits)
+	if !r.matches(national) || len(r.code)+len(national) > 15 {
+		return PhoneNumberUndefined
+	}
+	return PhoneNumber("+" + r.code + national)
+}
+
+// splitCallingCode splits international digits into the calling code and
+// the national number. Calling codes are prefix free.
+func splitCallingCode(digits string) (string, string) {
+	loadPhoneRegions()
+	for i := 1; i <= 3 && i < len(digits); i++ {
+		if _, ok := phoneCodes[digits[:i]]; ok {
+			return digits[:i], digits[i:]
+		}
+	}
+	return "", digits
+}
+
+func (p PhoneNumber) IsValid() bool {
+	return p != PhoneNumberUndefined
+}
+
+func (p PhoneNumber) String() string {
+	return string(p)
+}
+
+// CallingCode returns the country calling code without leading +.
+func (p PhoneNumber) CallingCode() string {
+	code, _ := splitCallingCode(strings.TrimPrefix(string(p), "+"))
+	return code
+}
+
+// NationalNumber returns the number without calling code and without
+// national trunk prefix.
+func (p PhoneNumber) NationalNumber() string {
+	_, national := splitCallingCode(strings.TrimPrefix(string(p), "+"))
+	return national
+}
+
+// Country returns the country the number belongs to. Numbers in calling
+// code zones shared by several countries like +1 and +7 are assigned using
+// their leading digits or numbering plan.
+func (p PhoneNumber) Country() iso.Country {
+	code, national := splitCallingCode(strings.TrimPrefix(string(p), "+"))
+	var main *phoneRegion
+	for _, r := range phoneCodes[code] {
+		switch {
+		case r.main:
+			main = r
+		case r.leading != nil:
+			if r.leading.MatchString(national) {
+				return r.country
+			}
+		case r.fixed != nil && r.fixed.MatchString(national),
+			r.mobile != nil && r.mobile.MatchString(national):
+			return r.country
+		}
+	}
+	if main != nil {
+		return main.country
+	}
+	if list := phoneCodes[code]; len(list) > 0 {
+		return list[0].country
+	}
+	return iso.CountryUndefined
+}
+
+// IsMobile returns true when the number is in a mobile number range of its
+// country. Numbering plans that share ranges between fixed and mobile
+// lines like the US and Canada cannot tell, their numbers return false.
+// Other members of the North American Numbering Plan like Jamaica keep
+// separate mobile ranges and are reported.
+func (p PhoneNumber) IsMobile() bool {
+	_, national := splitCallingCode(strings.TrimPrefix(string(p), "+"))
+	r := phoneRegions[p.Country()]
+	if r == nil || r.mobile == nil || !r.mobile.MatchString(national) {
+		return false
+	}
+	return r.fixed == nil || !r.fixed.MatchString(national)
+}
+
+// Text/JSON conversion
+func (p PhoneNumber) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+func (p *PhoneNumber) UnmarshalText(data []byte) error {
+	pp := ParsePhoneNumber(string(data), iso.CountryUndefined)
+	if !pp.IsValid() {
+		return fmt.Errorf("itu: invalid E.164 phone number '%s'", string(data))
+	}
+	*p = pp
+	return nil
+}
+
+// SQL conversion
+func (p *PhoneNumber) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		*p = ParsePhoneNumber(v, iso.CountryUndefined)
+	case []byte:
+		*p = ParsePhoneNumber(string(v), iso.CountryUndefined)
+	}
+	if !(*p).IsValid() {
+		return fmt.Errorf("itu: invalid E.164 phone number '%v'", value)
+	}
+	return nil
+}
+
+func (p PhoneNumber) Value() (driver.Value, error) {
+	return string(p), nil
+}
+
+// Datasets returns the upstream snapshots the tables were generated from,
+// keyed by name.
+func Datasets() map[string]iso.Dataset {
+	m := make(map[string]iso.Dataset, len(datasets))
+	for k, v := range datasets {
+		m[k] = v
+	}
+	return m
+}
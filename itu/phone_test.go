@@ -0,0 +1,128 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package itu
+
+import (
+	"testing"
+
+	"github.com/echa/code/iso"
+)
+
+func TestParsePhoneNumberTrunkPrefix(t *testing.T) {
+	tests := []struct {
+		in   string
+		want PhoneNumber
+	}{
+		{"+49 (0)30 901820", "+4930901820"},
+		{"+49(0)30 901820", "+4930901820"},
+		{"+44 (0) 20 7946 0958", "+442079460958"},
+		// (0) is part of the number after the area code
+		{"+49 30 (0)901820", "+49300901820"},
+		{"030 (0)901820", "+49300901820"},
+	}
+	for _, tt := range tests {
+		if got := ParsePhoneNumber(tt.in, iso.Country("DE")); got != tt.want {
+			t.Errorf("ParsePhoneNumber(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParsePhoneNumber(t *testing.T) {
+	tests := []struct {
+		in      string
+		country iso.Country
+		want    PhoneNumber
+	}{
+		// +1 shared zone
+		{"+1 650-253-0000", iso.CountryUndefined, "+16502530000"},
+		{"(650) 253-0000", iso.Country("US"), "+16502530000"},
+		{"1 650 253 0000", iso.Country("US"), "+16502530000"},
+		{"011 49 30 901820", iso.Country("US"), "+4930901820"},
+		{"416-555-0100", iso.Country("CA"), "+14165550100"},
+		{"876 210 1234", iso.Country("JM"), "+18762101234"},
+		{"+1 268 464 1234", iso.CountryUndefined, "+12684641234"},
+		// +7 shared zone
+		{"+7 912 345-67-89", iso.CountryUndefined, "+79123456789"},
+		{"8 (912) 345-67-89", iso.Country("RU"), "+79123456789"},
+		{"8 10 49 30 901820", iso.Country("RU"), "+4930901820"},
+		{"+7 727 258 2424", iso.CountryUndefined, "+77272582424"},
+		{"8 727 258 2424", iso.Country("KZ"), "+77272582424"},
+		// invalid input
+		{"", iso.Country("US"), PhoneNumberUndefined},
+		{"+", iso.CountryUndefined, PhoneNumberUndefined},
+		{"abc", iso.Country("US"), PhoneNumberUndefined},
+		{"+1 650 253 000", iso.CountryUndefined, PhoneNumberUndefined},
+		{"+1 650 253 00000", iso.CountryUndefined, PhoneNumberUndefined},
+		{"+1 150 253 0000", iso.CountryUndefined, PhoneNumberUndefined},
+		{"+7 012 345 6789", iso.CountryUndefined, PhoneNumberUndefined},
+		{"+4930901820123456789", iso.CountryUndefined, PhoneNumberUndefined},
+		{"650 253 0000", iso.CountryUndefined, PhoneNumberUndefined},
+		{"650 253 0000", iso.Country("XX"), PhoneNumberUndefined},
+	}
+	for _, tt := range tests {
+		if got := ParsePhoneNumber(tt.in, tt.country); got != tt.want {
+			t.Errorf("ParsePhoneNumber(%q, %s) = %q, want %q", tt.in, tt.country, got, tt.want)
+		}
+	}
+}
+
+func TestPhoneNumberFormat(t *testing.T) {
+	tests := []struct {
+		in       PhoneNumber
+		code     string
+		national string
+		country  iso.Country
+	}{
+		{"+16502530000", "1", "6502530000", iso.Country("US")},
+		{"+14165550100", "1", "4165550100", iso.Country("CA")},
+		{"+18762101234", "1", "8762101234", iso.Country("JM")},
+		{"+12684641234", "1", "2684641234", iso.Country("AG")},
+		{"+79123456789", "7", "9123456789", iso.Country("RU")},
+		{"+77272582424", "7", "7272582424", iso.Country("KZ")},
+		{"+4930901820", "49", "30901820", iso.Country("DE")},
+		{"+3545512345", "354", "5512345", iso.Country("IS")},
+		{PhoneNumberUndefined, "", "", iso.CountryUndefined},
+	}
+	for _, tt := range tests {
+		if got := tt.in.String(); got != string(tt.in) {
+			t.Errorf("%s: String = %q, want %q", tt.in, got, string(tt.in))
+		}
+		if got := tt.in.CallingCode(); got != tt.code {
+			t.Errorf("%s: CallingCode = %q, want %q", tt.in, got, tt.code)
+		}
+		if got := tt.in.NationalNumber(); got != tt.national {
+			t.Errorf("%s: NationalNumber = %q, want %q", tt.in, got, tt.national)
+		}
+		if got := tt.in.Country(); got != tt.country {
+			t.Errorf("%s: Country = %s, want %s", tt.in, got, tt.country)
+		}
+	}
+}
+
+func TestPhoneNumberIsMobile(t *testing.T) {
+	tests := []struct {
+		in   PhoneNumber
+		want bool
+	}{
+		{"+4915112345678", true},
+		{"+4930901820", false},
+		{"+33612345678", true},
+		{"+79123456789", true},
+		// US and Canada do not tell mobile from fixed lines
+		{"+16502530000", false},
+		{"+12125550100", false},
+		{"+14165550100", false},
+		// other NANP members keep separate mobile ranges
+		{"+18762101234", true},
+		{"+18769251234", false},
+		{"+12684641234", true},
+		{"+12684601234", false},
+		{PhoneNumberUndefined, false},
+	}
+	for _, tt := range tests {
+		if got := tt.in.IsMobile(); got != tt.want {
+			t.Errorf("%s: IsMobile = %t, want %t", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package upu
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/echa/code/iso"
+)
+
+// Address is an international postal address.
+type Address struct {
+	Recipient         string      `json:"recipient,omitempty"`
+	Organization      string      `json:"organization,omitempty"`
+	Lines             []string    `json:"lines,omitempty"` // street, house number, building, unit
+	DependentLocality string      `json:"dependent_locality,omitempty"`
+	Locality          string      `json:"locality,omitempty"` // city or town
+	Region            iso.Region  `json:"region,omitempty"`
+	PostalCode        PostalCode  `json:"postal_code,omitempty"`
+	Country           iso.Country `json:"country"`
+}
+
+// addressFormat is a layout template in the notation of Google's address
+// metadata. Fields are %N recipient, %O organization, %A address lines,
+// %D dependent locality, %C locality, %S region and %Z postal code, %n
+// starts a new line.
+type addressFormat struct {
+	Format      string // local layout
+	LatinFormat string // layout for addresses written in Latin script, if different
+	Require     string // required fields
+	Upper       string // fields written in upper case
+	RegionCode  bool   // regions are written as the code after the country prefix, e.g. CA
+}
+
+var defaultAddressFormat = addressFormat{Format: "%N%n%O%n%A%n%C", Require: "AC"}
+
+// Address layouts by country. Countries not listed use the default layout.
+// https://github.com/google/libaddressinput (chromium-i18n.appspot.com/ssl-address)
+var address_formats = map[string]addressFormat{
+	"AR": {Format: "%N%n%O%n%A%n%Z %C%n%S", Require: "AC", Upper: "ACZ"},
+	"AT": {Format: "%O%n%N%n%A%n%Z %C", Require: "ACZ"},
+	"AU": {Format: "%O%n%N%n%A%n%C %S %Z", Require: "ACSZ", Upper: "CS", RegionCode: true},
+	"BE": {Format: "%O%n%N%n%A%n%Z %C", Require: "ACZ"},
+	"BR": {Format: "%O%n%N%n%A%n%D%n%C-%S%n%Z", Require: "ASCZ", Upper: "CS", RegionCode: true},
+	"CA": {Format: "%N%n%O%n%A%n%C %S %Z", Require: "ACSZ", Upper: "ACNOSZ", RegionCode: true},
+	"CH": {Format: "%O%n%N%n%A%nCH-%Z %C", Require: "ACZ"},
+	"CN": {Format: "%Z%n%S%C%D%n%A%n%O%n%N", LatinFormat: "%N%n%O%n%A%n%D%n%C%n%S, %Z", Require: "ACSZ"},
+	"DE": {Format: "%N%n%O%n%A%n%Z %C", Require: "ACZ"},
+	"DK": {Format: "%N%n%O%n%A%n%Z %C", Require: "ACZ"},
+	"ES": {Format: "%N%n%O%n%A%n%Z %C %S", Require: "ACSZ", Upper: "CS"},
+	"FI": {Format: "%O%n%N%n%A%n%Z %C", Require: "ACZ"},
+	"FR": {Format: "%O%n%N%n%A%n%Z %C", Require: "ACZ", Upper: "C"},
+	"GB": {Format: "%N%n%O%n%A%n%C%n%Z", Require: "ACZ", Upper: "CZ"},
+	"IE": {Format: "%N%n%O%n%A%n%D%n%C%n%S%n%Z", Upper: "CZ"},
+	"IN": {Format: "%N%n%O%n%A%n%C %Z%n%S", Require: "ACSZ"},
+	"IT": {Format: "%N%n%O%n%A%n%Z %C %S", Require: "ACSZ", Upper: "CS", RegionCode: true},
+	"JP": {Format: "〒%Z%n%S%n%A%n%O%n%N", LatinFormat: "%N%n%O%n%A, %S%n%Z", Require: "ASZ", Upper: "S"},
+	"KR": {Format: "%S %C%D%n%A%n%O%n%N%n%Z", LatinFormat: "%N%n%O%n%A%n%D%n%C%n%S%n%Z", Require: "ACSZ", Upper: "Z"},
+	"MX": {Format: "%N%n%O%n%A%n%D%n%Z %C, %S", Require: "ACSZ", Upper: "CSZ"},
+	"NL": {Format: "%O%n%N%n%A%n%Z %C", Require: "ACZ"},
+	"NO": {Format: "%N%n%O%n%A%n%Z %C", Require: "ACZ"},
+	"NZ": {Format: "%N%n%O%n%A%n%D%n%C %Z", Require: "ACZ"},
+	"PL": {Format: "%N%n%O%n%A%n%Z %C", Require: "ACZ"},
+	"PT": {Format: "%N%n%O%n%A%n%Z %C", Require: "ACZ"},
+	"RU": {Format: "%N%n%O%n%A%n%C%n%S%n%Z", LatinFormat: "%N%n%O%n%A%n%C%n%S%n%Z", Require: "ACSZ", Upper: "AC"},
+	"SE": {Format: "%O%n%N%n%A%n%Z %C", Require: "ACZ"},
+	"US": {Format: "%N%n%O%n%A%n%C, %S %Z", Require: "ACSZ", Upper: "CS", RegionCode: true},
+}
+
+func formatFor(c iso.Country) addressFormat {
+	if f, ok := address_formats[string(c)]; ok {
+		return f
+	}
+	return defaultAddressFormat
+}
+
+// field returns the value of address field f.
+func (a Address) field(f byte, regionCode, latin bool) []string {
+	switch f {
+	case 'N':
+		return []string{a.Recipient}
+	case 'O':
+		return []string{a.Organization}
+	case 'A':
+		return a.Lines
+	case 'D':
+		return []string{a.DependentLocality}
+	case 'C':
+		return []string{a.Locality}
+	case 'S':
+		if !a.Region.IsValid() {
+			return nil
+		}
+		if regionCode {
+			return []string{strings.TrimPrefix(string(a.Region), string(a.Country)+"-")}
+		}
+		if latin {
+			return []string{a.Region.String()}
+		}
+		return []string{a.Region.LocalName()}
+	case 'Z':
+		return []string{string(a.PostalCode)}
+	}
+	return nil
+}
+
+var addressFieldNames = map[byte]string{
+	'N': "recipient",
+	'O': "organization",
+	'A': "address lines",
+	'D': "dependent locality",
+	'C': "locality",
+	'S': "region",
+	'Z': "postal code",
+}
+
+// Validate checks that all fields required in the address's country are
+// present, that the postal code matches the country's format and that the
+// region belongs to the country.
+func (a Address) Validate() error {
+	if !iso.ParseCountry(string(a.Country)).IsValid() {
+		return fmt.Errorf("upu: invalid address country '%s'", string(a.Country))
+	}
+	f := formatFor(a.Country)
+	var missing []string
+	for i := 0; i < len(f.Require); i++ {
+		empty := true
+		for _, v := range a.field(f.Require[i], f.RegionCode, false) {
+			if strings.TrimSpace(v) != "" {
+				empty = false
+			}
+		}
+		if empty {
+			missing = append(missing, addressFieldNames[f.Require[i]])
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("upu: address in %s is missing %s", string(a.Country), strings.Join(missing, ", "))
+	}
+	if a.Region.IsValid() && a.Region.Country() != a.Country {
+		return fmt.Errorf("upu: region '%s' is not in country %s", string(a.Region), string(a.Country))
+	}
+	if a.PostalCode.IsValid() && HasPostalCodes(a.Country) && !ParsePostalCode(string(a.PostalCode), a.Country).IsValid() {
+		return fmt.Errorf("upu: invalid postal code '%s' for country %s", string(a.PostalCode), string(a.Country))
+	}
+	return nil
+}
+
+// Normalize returns a copy of the address with the postal code in its
+// country's canonical format. Invalid postal codes are kept unchanged.
+func (a Address) Normalize() Address {
+	if p := ParsePostalCode(string(a.PostalCode), a.Country); p.IsValid() {
+		a.PostalCode = p
+	}
+	a.Lines = append([]string(nil), a.Lines...)
+	return a
+}
+
+// Label returns the lines of a mailing label in the local layout of the
+// address's country, e.g. big to small for Japan. When the address is
+// sent from another country, the destination country is added in capital
+// letters as the last line. Valid postal codes are written in their
+// canonical format, see Normalize.
+func (a Address) Label(from iso.Country) []string {
+	f := formatFor(a.Country)
+	return a.Normalize().render(f.Format, f, from, false)
+}
+
+// LatinLabel works like Label, but uses the layout for addresses written
+// in Latin script for countries that have one, e.g. small to big for
+// Japan.
+func (a Address) LatinLabel(from iso.Country) []string {
+	f := formatFor(a.Country)
+	if f.LatinFormat == "" {
+		return a.Normalize().render(f.Format, f, from, false)
+	}
+	return a.Normalize().render(f.LatinFormat, f, from, true)
+}
+
+// String returns the address on a single line for display, including the
+// country.
+func (a Address) String() string {
+	return strings.Join(a.LatinLabel(iso.CountryUndefined), ", ")
+}
+
+type layoutItem struct {
+	field   byte // zero for literals
+	literal string
+}
+
+func (a Address) render(layout string, f addressFormat, from iso.Country, latin bool) []string {
+	lines := make([]string, 0)
+	for _, l := range strings.Split(layout, "%n") {
+		items := make([]layoutItem, 0)
+		for len(l) > 0 {
+			if i := strings.IndexByte(l, '%'); i < 0 {
+				items, l = append(items, layoutItem{literal: l}), ""
+			} else if i > 0 {
+				items, l = append(items, layoutItem{literal: l[:i]}), l[i:]
+			} else if len(l) > 1 {
+				items, l = append(items, layoutItem{field: l[1]}), l[2:]
+			} else {
+				l = ""
+			}
+		}
+		// a line made of only address lines expands to several lines
+		if len(items) == 1 && items[0].field == 'A' {
+			for _, v := range a.Lines {
+				if v = strings.TrimSpace(v); v != "" && strings.IndexByte(f.Upper, 'A') >= 0 {
+					lines = append(lines, strings.ToUpper(v))
+				} else if v != "" {
+					lines = append(lines, v)
+				}
+			}
+			continue
+		}
+		values := make([]string, len(items))
+		for i, it := range items {
+			if it.field == 0 {
+				continue
+			}
+			parts := make([]string, 0)
+			for _, v := range a.field(it.field, f.RegionCode, latin) {
+				if v = strings.TrimSpace(v); v != "" {
+					parts = append(parts, v)
+				}
+			}
+			values[i] = strings.Join(parts, ", ")
+			if strings.IndexByte(f.Upper, it.field) >= 0 {
+				values[i] = strings.ToUpper(values[i])
+			}
+		}
+		// Literals separate present fields. The literals around an empty
+		// field collapse into the one following it, so "%C, %S %Z" without
+		// region becomes "MOUNTAIN VIEW 94043". A leading literal is kept
+		// with a present first field, a trailing one with a present last
+		// field.
+		var (
+			b       strings.Builder
+			pending string // literal before the next present field
+			leading bool   // pending is the literal starting the line
+			started bool
+			last    bool // the most recent field was present
+		)
+		for i, it := range items {
+			switch {
+			case it.field == 0 && i > 0 && i == len(items)-1:
+				if last {
+					b.WriteString(it.literal)
+				}
+			case it.field == 0:
+				pending, leading = it.literal, i == 0
+			case values[i] == "":
+				leading, last = false, false
+			default:
+				if started || leading {
+					b.WriteString(pending)
+				}
+				b.WriteString(values[i])
+				pending, leading, started, last = "", false, true, true
+			}
+		}
+		if s := strings.TrimSpace(b.String()); s != "" {
+			lines = append(lines, s)
+		}
+	}
+	if a.Country.IsValid() && a.Country != from {
+		lines = append(lines, strings.ToUpper(a.Country.String()))
+	}
+	return lines
+}
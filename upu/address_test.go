@@ -0,0 +1,68 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package upu
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/echa/code/iso"
+)
+
+func TestAddressLabel(t *testing.T) {
+	us := Address{
+		Recipient:  "Jane Doe",
+		Lines:      []string{"1600 Amphitheatre Pkwy"},
+		Locality:   "Mountain View",
+		Region:     "US-CA",
+		PostalCode: "94043",
+		Country:    "US",
+	}
+	noRegion, noLocality, noPostalCode := us, us, us
+	noRegion.Region = ""
+	noLocality.Locality = ""
+	noPostalCode.PostalCode = ""
+
+	tests := []struct {
+		addr Address
+		from iso.Country
+		want []string
+	}{
+		{us, "US", []string{"Jane Doe", "1600 Amphitheatre Pkwy", "MOUNTAIN VIEW, CA 94043"}},
+		{noRegion, "US", []string{"Jane Doe", "1600 Amphitheatre Pkwy", "MOUNTAIN VIEW 94043"}},
+		{noLocality, "US", []string{"Jane Doe", "1600 Amphitheatre Pkwy", "CA 94043"}},
+		{noPostalCode, "US", []string{"Jane Doe", "1600 Amphitheatre Pkwy", "MOUNTAIN VIEW, CA"}},
+		{
+			Address{Recipient: "Jane Doe", Lines: []string{"290 Bremner Blvd"}, Locality: "Toronto", Region: "CA-ON", PostalCode: "m5v3l9", Country: "CA"},
+			"DE",
+			[]string{"JANE DOE", "290 BREMNER BLVD", "TORONTO ON M5V 3L9", "CANADA"},
+		},
+		{
+			Address{Recipient: "Mario Rossi", Lines: []string{"Via Roma 1"}, Locality: "Roma", PostalCode: "00184", Country: "IT"},
+			"IT",
+			[]string{"Mario Rossi", "Via Roma 1", "00184 ROMA"},
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.addr.Label(tt.from); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Label(%+v) = %q, want %q", tt.addr, got, tt.want)
+		}
+	}
+}
+
+func TestAddressLatinLabel(t *testing.T) {
+	jp := Address{
+		Recipient:  "Taro Yamada",
+		Lines:      []string{"1-1 Marunouchi, Chiyoda-ku"},
+		Region:     "JP-13",
+		PostalCode: "1000005",
+		Country:    "JP",
+	}
+	if got, want := jp.Label("JP"), []string{"〒100-0005", "東京都", "1-1 Marunouchi, Chiyoda-ku", "Taro Yamada"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Label() = %q, want %q", got, want)
+	}
+	if got, want := jp.LatinLabel("JP"), []string{"Taro Yamada", "1-1 Marunouchi, Chiyoda-ku, TOKYO", "100-0005"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("LatinLabel() = %q, want %q", got, want)
+	}
+}
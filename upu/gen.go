@@ -0,0 +1,95 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+//go:build ignore
+// +build ignore
+
+// This program generates tables.go from the CLDR postal code snapshot in
+// the data directory. Run it with go generate. A report of added, removed
+// and changed entries is written to stdout.
+//
+// To refresh the tables, replace the snapshot with a current download of
+// common/supplemental/postalCodeData.xml from
+// https://github.com/unicode-org/cldr. CLDR no longer maintains the file,
+// so postalCorrections replaces outdated patterns and territories that are
+// not in ISO 3166-1 like CS and YU are dropped.
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/echa/code/internal/gen"
+	"github.com/echa/code/iso"
+)
+
+// Patterns of postal code systems introduced after the CLDR data was last
+// updated, both in 2015: Irish Eircodes and five digit Korean codes.
+var postalCorrections = map[string]string{
+	"IE": `(?:[AC-FHKNPRTV-Y]\d{2}|D6W) ?[0-9AC-FHKNPRTV-Y]{4}`,
+	"KR": `\d{5}`,
+}
+
+func main() {
+	f, err := os.Open("data/postalCodeData.xml")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var doc struct {
+		Regex []struct {
+			Territory string `xml:"territoryId,attr"`
+			Pattern   string `xml:",chardata"`
+		} `xml:"postalCodeData>postCodeRegex"`
+	}
+	if err := xml.NewDecoder(f).Decode(&doc); err != nil {
+		log.Fatalf("data/postalCodeData.xml: %v", err)
+	}
+	f.Close()
+	patterns := make(map[string]string, len(doc.Regex))
+	for _, v := range doc.Regex {
+		if _, ok := iso.Default().CountryName(iso.Country(v.Territory)); ok {
+			patterns[v.Territory] = strings.TrimSpace(v.Pattern)
+		}
+	}
+	for c, p := range postalCorrections {
+		patterns[c] = p
+	}
+	codes := make([]string, 0, len(patterns))
+	for c := range patterns {
+		codes = append(codes, c)
+	}
+	sort.Strings(codes)
+
+	var b bytes.Buffer
+	b.WriteString("package upu\n\n")
+	b.WriteString("import \"github.com/echa/code/iso\"\n\n")
+	b.WriteString("// Postal code patterns by ISO 3166-1 country code.\n")
+	b.WriteString("// http://cldr.unicode.org\n")
+	b.WriteString("var postal_code_patterns = map[string]string{\n")
+	for _, c := range codes {
+		if _, err := regexp.Compile(patterns[c]); err != nil {
+			log.Fatalf("data/postalCodeData.xml: %s: %v", c, err)
+		}
+		fmt.Fprintf(&b, "\t%q: %q,\n", c, patterns[c])
+	}
+	b.WriteString("}\n\n")
+
+	sum, err := gen.Checksum("data/postalCodeData.xml")
+	if err != nil {
+		log.Fatal(err)
+	}
+	b.WriteString("// Upstream snapshots the tables were generated from, see Datasets.\n")
+	b.WriteString("var datasets = map[string]iso.Dataset{\n")
+	fmt.Fprintf(&b, "\t%q: {File: %q, Published: %q, Checksum: %q},\n", "CLDR postal codes", "data/postalCodeData.xml", "seed", sum)
+	b.WriteString("}\n")
+
+	if err := gen.WriteGoFile("tables.go", b.Bytes(), os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package upu
+
+//go:generate go run gen.go
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/echa/code/iso"
+)
+
+// postalLayout describes where the canonical form of a postal code has a
+// separator. Negative positions count from the end.
+type postalLayout struct {
+	sep string
+	pos int
+}
+
+// Canonical postal code layouts for countries whose patterns allow an
+// optional separator or require one.
+var postal_layouts = map[string]postalLayout{
+	"AS": {"-", 5},
+	"BR": {"-", 5},
+	"CA": {" ", 3},
+	"CZ": {" ", 3},
+	"GB": {" ", -3}, // outward and inward code
+	"GG": {" ", -3},
+	"GR": {" ", 3},
+	"GU": {"-", 5},
+	"IE": {" ", 3},
+	"IM": {" ", -3},
+	"JE": {" ", -3},
+	"JP": {"-", 3},
+	"MP": {"-", 5},
+	"MT": {" ", 3},
+	"NL": {" ", 4},
+	"PL": {"-", 2},
+	"PR": {"-", 5},
+	"PT": {"-", 4},
+	"SE": {" ", 3},
+	"SK": {" ", 3},
+	"US": {"-", 5},
+	"VI": {"-", 5},
+}
+
+// postalRange maps postal codes whose prefix lies between From and To to
+// a region. The first matching range wins.
+type postalRange struct {
+	From   string
+	To     string
+	Region iso.Region
+}
+
+// Postal code prefixes of US states and territories (ZIP3) and Canadian
+// provinces (first letter of the forward sortation area). Military and
+// shared prefixes are not mapped.
+// https://pe.usps.com/text/LabelingLists/L002.htm
+// https://www.canadapost-postescanada.ca/cpc/en/support/kb/sending/general-information/postal-codes-and-forward-sortation-areas
+var postal_regions = map[string][]postalRange{
+	"CA": {
+		{"A", "A", "CA-NL"},
+		{"B", "B", "CA-NS"},
+		{"C", "C", "CA-PE"},
+		{"E", "E", "CA-NB"},
+		{"G", "J", "CA-QC"},
+		{"K", "P", "CA-ON"},
+		{"R", "R", "CA-MB"},
+		{"S", "S", "CA-SK"},
+		{"T", "T", "CA-AB"},
+		{"V", "V", "CA-BC"},
+		{"X0A", "X0C", "CA-NU"},
+		{"X", "X", "CA-NT"},
+		{"Y", "Y", "CA-YT"},
+	},
+	"US": {
+		{"005", "005", "US-NY"},
+		{"006", "007", "US-PR"},
+		{"008", "008", "US-VI"},
+		{"009", "009", "US-PR"},
+		{"010", "027", "US-MA"},
+		{"028", "029", "US-RI"},
+		{"030", "038", "US-NH"},
+		{"039", "049", "US-ME"},
+		{"050", "054", "US-VT"},
+		{"055", "055", "US-MA"},
+		{"056", "059", "US-VT"},
+		{"060", "069", "US-CT"},
+		{"070", "089", "US-NJ"},
+		{"100", "149", "US-NY"},
+		{"150", "196", "US-PA"},
+		{"197", "199", "US-DE"},
+		{"200", "200", "US-DC"},
+		{"201", "201", "US-VA"},
+		{"202", "205", "US-DC"},
+		{"206", "219", "US-MD"},
+		{"220", "246", "US-VA"},
+		{"247", "268", "US-WV"},
+		{"270", "289", "US-NC"},
+		{"290", "299", "US-SC"},
+		{"300", "319", "US-GA"},
+		{"320", "339", "US-FL"},
+		{"341", "349", "US-FL"},
+		{"350", "369", "US-AL"},
+		{"370", "385", "US-TN"},
+		{"386", "397", "US-MS"},
+		{"398", "399", "US-GA"},
+		{"400", "427", "US-KY"},
+		{"430", "459", "US-OH"},
+		{"460", "479", "US-IN"},
+		{"480", "499", "US-MI"},
+		{"500", "528", "US-IA"},
+		{"530", "549", "US-WI"},
+		{"550", "567", "US-MN"},
+		{"569", "569", "US-DC"},
+		{"570", "577", "US-SD"},
+		{"580", "588", "US-ND"},
+		{"590", "599", "US-MT"},
+		{"600", "629", "US-IL"},
+		{"630", "658", "US-MO"},
+		{"660", "679", "US-KS"},
+		{"680", "693", "US-NE"},
+		{"700", "714", "US-LA"},
+		{"716", "729", "US-AR"},
+		{"730", "731", "US-OK"},
+		{"733", "733", "US-TX"},
+		{"734", "749", "US-OK"},
+		{"750", "799", "US-TX"},
+		{"800", "816", "US-CO"},
+		{"820", "831", "US-WY"},
+		{"832", "838", "US-ID"},
+		{"840", "847", "US-UT"},
+		{"850", "865", "US-AZ"},
+		{"870", "884", "US-NM"},
+		{"885", "885", "US-TX"},
+		{"889", "898", "US-NV"},
+		{"900", "961", "US-CA"},
+		{"967", "968", "US-HI"},
+		{"970", "979", "US-OR"},
+		{"980", "994", "US-WA"},
+		{"995", "999", "US-AK"},
+	},
+}
+
+var (
+	postalOnce     sync.Once
+	postalPatterns map[string]*regexp.Regexp
+)
+
+func loadPostalPatterns() {
+	postalOnce.Do(func() {
+		postalPatterns = make(map[string]*regexp.Regexp, len(postal_code_patterns))
+		for k, v := range postal_code_patterns {
+			postalPatterns[k] = regexp.MustCompile("^(?:" + v + ")$")
+		}
+	})
+}
+
+// HasPostalCodes returns true when country c uses postal codes with a
+// known format.
+func HasPostalCodes(c iso.Country) bool {
+	loadPostalPatterns()
+	_, ok := postalPatterns[string(c)]
+	return ok
+}
+
+// Postal code in the canonical format of its country, e.g. "SW1A 1AA",
+// "K1A 0B1" or "1012 JS"
+type PostalCode string
+
+const (
+	PostalCodeUndefined PostalCode = ""
+)
+
+// cleanPostalCode upper-cases s and collapses runs of white space into a
+// single space.
+func cleanPostalCode(s string) string {
+	return strings.Join(strings.Fields(strings.ToUpper(s)), " ")
+}
+
+// ParsePostalCode validates s against the postal code format of country c
+// and returns it in canonical format. Separators may be missing or
+// different from the canonical format, a leading country code like in
+// "NL-1012 JS" is removed. Returns PostalCodeUndefined when the code is
+// invalid or country c has no known postal code format.
+func ParsePostalCode(s string, c iso.Country) PostalCode {
+	loadPostalPatterns()
+	rx, ok := postalPatterns[string(c)]
+	if !ok {
+		return PostalCodeUndefined
+	}
+	clean := cleanPostalCode(s)
+	candidates := []string{clean}
+	if p := string(c) + "-"; strings.HasPrefix(clean, p) {
+		candidates = append(candidates, clean[len(p):])
+	}
+	for _, v := range candidates {
+		compact := strings.NewReplacer(" ", "", "-", "").Replace(v)
+		if l, ok := postal_layouts[string(c)]; ok {
+			if x := l.apply(compact); rx.MatchString(x) {
+				return PostalCode(x)
+			}
+		}
+		if rx.MatchString(compact) {
+			return PostalCode(compact)
+		}
+		if rx.MatchString(v) {
+			return PostalCode(v)
+		}
+	}
+	return PostalCodeUndefined
+}
+
+// apply inserts the separator into a postal code without separators.
+func (l postalLayout) apply(s string) string {
+	// British forces post office numbers have a variable length
+	if strings.HasPrefix(s, "BFPO") {
+		return "BFPO " + s[4:]
+	}
+	pos := l.pos
+	if pos < 0 {
+		pos += len(s)
+	}
+	if pos <= 0 || pos >= len(s) {
+		return s
+	}
+	return s[:pos] + l.sep + s[pos:]
+}
+
+func (p PostalCode) IsValid() bool {
+	return p != PostalCodeUndefined
+}
+
+func (p PostalCode) String() string {
+	return string(p)
+}
+
+// Region returns the region of country c the postal code belongs to or
+// RegionUndefined when the country's postal codes do not map to regions.
+func (p PostalCode) Region(c iso.Country) iso.Region {
+	for _, r := range postal_regions[string(c)] {
+		if len(p) < len(r.From) {
+			continue
+		}
+		if x := string(p[:len(r.From)]); x >= r.From && x <= r.To {
+			return r.Region
+		}
+	}
+	return iso.RegionUndefined
+}
+
+// Text/JSON conversion
+func (p PostalCode) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText accepts any postal code made of letters, digits, spaces
+// and hyphens since the country is unknown. Use ParsePostalCode to
+// validate against a country's format.
+func (p *PostalCode) UnmarshalText(data []byte) error {
+	s := cleanPostalCode(string(data))
+	if s == "" || len(s) > 12 || strings.Trim(s, "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 -") != "" {
+		return fmt.Errorf("upu: invalid postal code '%s'", string(data))
+	}
+	*p = PostalCode(s)
+	return nil
+}
+
+// SQL conversion
+func (p *PostalCode) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		return p.UnmarshalText([]byte(v))
+	case []byte:
+		return p.UnmarshalText(v)
+	}
+	return fmt.Errorf("upu: invalid postal code '%v'", value)
+}
+
+func (p PostalCode) Value() (driver.Value, error) {
+	return string(p), nil
+}
+
+// Datasets returns the upstream snapshots the tables were generated from,
+// keyed by name.
+func Datasets() map[string]iso.Dataset {
+	m := make(map[string]iso.Dataset, len(datasets))
+	for k, v := range datasets {
+		m[k] = v
+	}
+	return m
+}
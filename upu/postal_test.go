@@ -0,0 +1,59 @@
+// Copyright (c) 2013-2020 KIDTSUNAMI
+// Author: alex@kidtsunami.com
+
+package upu
+
+import (
+	"testing"
+
+	"github.com/echa/code/iso"
+)
+
+func TestParsePostalCode(t *testing.T) {
+	tests := []struct {
+		code    string
+		country iso.Country
+		want    PostalCode
+	}{
+		{"06236", "KR", "06236"},
+		{"135-080", "KR", PostalCodeUndefined}, // six digit codes until 2015
+		{"D02 X285", "IE", "D02 X285"},
+		{"d02x285", "IE", "D02 X285"},
+		{"D6W 1234", "IE", "D6W 1234"},
+		{"B02 X285", "IE", PostalCodeUndefined},
+		{"sw1a1aa", "GB", "SW1A 1AA"},
+		{"1000005", "JP", "100-0005"},
+		{"NL-1012JS", "NL", "1012 JS"},
+		{"94043-1351", "US", "94043-1351"},
+		{"9404", "US", PostalCodeUndefined},
+		{"11000", "CS", PostalCodeUndefined},
+		{"11000", "YU", PostalCodeUndefined},
+	}
+	for _, tt := range tests {
+		if got := ParsePostalCode(tt.code, tt.country); got != tt.want {
+			t.Errorf("ParsePostalCode(%q, %s) = %q, want %q", tt.code, tt.country, got, tt.want)
+		}
+	}
+	for _, c := range []iso.Country{"CS", "YU"} {
+		if HasPostalCodes(c) {
+			t.Errorf("%s: withdrawn country has postal codes", c)
+		}
+	}
+}
+
+func TestAddressValidate(t *testing.T) {
+	tests := []struct {
+		addr Address
+		ok   bool
+	}{
+		{Address{Lines: []string{"Sejong-daero 110"}, Locality: "Jung-gu", Region: "KR-11", PostalCode: "04524", Country: "KR"}, true},
+		{Address{Lines: []string{"Sejong-daero 110"}, Locality: "Jung-gu", Region: "KR-11", PostalCode: "100-744", Country: "KR"}, false},
+		{Address{Lines: []string{"1 College Green"}, Locality: "Dublin", PostalCode: "D02 X285", Country: "IE"}, true},
+		{Address{Lines: []string{"1 College Green"}, Locality: "Dublin", PostalCode: "D02", Country: "IE"}, false},
+	}
+	for _, tt := range tests {
+		if err := tt.addr.Validate(); (err == nil) != tt.ok {
+			t.Errorf("Validate(%+v) = %v", tt.addr, err)
+		}
+	}
+}